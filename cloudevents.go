@@ -0,0 +1,110 @@
+package jetbridge
+
+import (
+	"encoding/json"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// CloudEventsSpecVersion is the version of the CloudEvents specification
+	// followed by CloudEvent.
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventType is the type of the events sent for JetStream messages.
+	CloudEventType = "io.github.joereid.jetbridge.message"
+)
+
+// CloudEvent is a message in the cloudevents payload format, a CloudEvents 1.0
+// event in the structured JSON format.
+//
+// The event's source identifies the stream, as /jetstream/<stream>, and its id
+// is the stream sequence of the message, so that redeliveries of a message are
+// the same event. The subject is the message's subject.
+//
+// Data is set to the message data if the message has a JSON Content-Type
+// header and its data is valid JSON, otherwise DataBase64 is set. Message
+// headers other than Content-Type are not sent, as extension attributes
+// cannot hold them.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      []byte          `json:"data_base64,omitempty"`
+
+	// Extension attributes describing the JetStream message.
+
+	NATSStream   string `json:"natsstream"`
+	NATSConsumer string `json:"natsconsumer"`
+	// NATSSequence is the stream sequence, which identifies the message in a
+	// JetstreamBatchItemFailure. It is encoded as a string, as CloudEvents
+	// integers are limited to 32 bits.
+	NATSSequence  uint64 `json:"natssequence,string"`
+	NATSDelivered uint64 `json:"natsdelivered"`
+}
+
+// CloudEventBatch is a batch of messages in the cloudevents payload format,
+// in the CloudEvents JSON batch format.
+type CloudEventBatch []CloudEvent
+
+// NewCloudEvent converts a message to the cloudevents payload format.
+func NewCloudEvent(payload JetstreamLambdaPayload) CloudEvent {
+	event := CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              strconv.FormatUint(payload.Metadata.Sequence.Stream, 10),
+		Source:          "/jetstream/" + payload.Metadata.Stream,
+		Type:            CloudEventType,
+		Subject:         payload.Subject,
+		Time:            payload.Metadata.Timestamp,
+		DataContentType: payload.Header.Get("Content-Type"),
+		NATSStream:      payload.Metadata.Stream,
+		NATSConsumer:    payload.Metadata.Consumer,
+		NATSSequence:    payload.Metadata.Sequence.Stream,
+		NATSDelivered:   payload.Metadata.NumDelivered,
+	}
+
+	if isJSONContentType(event.DataContentType) && json.Valid(payload.Data) {
+		event.Data = payload.Data
+	} else {
+		event.DataBase64 = payload.Data
+	}
+
+	return event
+}
+
+// NewCloudEventBatch converts a batch of messages to the cloudevents payload
+// format.
+func NewCloudEventBatch(batch JetstreamBatchedLambdaPayload) CloudEventBatch {
+	events := make(CloudEventBatch, 0, len(batch))
+	for _, payload := range batch {
+		events = append(events, NewCloudEvent(payload))
+	}
+
+	return events
+}
+
+// Bytes returns the data of the event, however it was encoded.
+func (e CloudEvent) Bytes() []byte {
+	if e.Data != nil {
+		return e.Data
+	}
+
+	return e.DataBase64
+}
+
+// isJSONContentType reports whether a content type is JSON, either
+// application/json or a type with the +json suffix.
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == ContentTypeJSON || strings.HasSuffix(mediaType, "+json")
+}
@@ -3,8 +3,10 @@ package commands
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/JoeReid/jetbridge/cmd/cli/prettyprint"
@@ -23,30 +25,287 @@ var Binding = &cli.Command{
 	Subcommands: []*cli.Command{
 		BindingCreate,
 		BindingList,
+		BindingUpdate,
 		BindingDelete,
+		BindingStatus,
+		BindingPause,
+		BindingResume,
 	},
 }
 
 var (
 	lambdaARN       string
+	lambdaQualifier string
+	webhookURL      string
+	natsSubject     string
+
+	webhookHeaders       cli.StringSlice
+	webhookSigningSecret string
+	webhookTimeout       time.Duration
+	webhookInsecure      bool
+
 	stream          string
 	subject         string
 	maxBatchSize    int
 	maxBatchLatency time.Duration
 	startFrom       string
+
+	maxDeliveries     int
+	deadLetterSubject string
+
+	retryInitialDelay time.Duration
+	retryMultiplier   float64
+	retryMaxDelay     time.Duration
+	retryJitter       float64
+
+	invocationType string
+
+	payloadFormat string
+
+	maxConcurrency int
+
+	partitionSubjectToken uint
+	partitionHeader       string
+
+	requiredLabels cli.StringSlice
 )
 
-var BindingCreate = &cli.Command{
-	Name:    "create",
-	Aliases: []string{"c"},
-	Usage:   "create a new binding",
-	Flags: []cli.Flag{
+var invocationTypes = map[string]v1.InvocationType{
+	"RequestResponse": v1.InvocationType_INVOCATION_TYPE_REQUEST_RESPONSE,
+	"Event":           v1.InvocationType_INVOCATION_TYPE_EVENT,
+	"DryRun":          v1.InvocationType_INVOCATION_TYPE_DRY_RUN,
+}
+
+func invocationTypeFlag(value string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        "invocation-type",
+		Usage:       "how to invoke a lambda target, one of 'RequestResponse', 'Event' or 'DryRun'",
+		Value:       value,
+		Destination: &invocationType,
+		Action: func(c *cli.Context, v string) error {
+			if _, ok := invocationTypes[v]; !ok {
+				return fmt.Errorf("invalid invocation type: %q", v)
+			}
+
+			return nil
+		},
+	}
+}
+
+var payloadFormats = map[string]v1.PayloadFormat{
+	"jetstream":   v1.PayloadFormat_PAYLOAD_FORMAT_JETSTREAM,
+	"json":        v1.PayloadFormat_PAYLOAD_FORMAT_JSON,
+	"cloudevents": v1.PayloadFormat_PAYLOAD_FORMAT_CLOUDEVENTS,
+	"raw":         v1.PayloadFormat_PAYLOAD_FORMAT_RAW,
+}
+
+func payloadFormatFlag(value string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:        "payload-format",
+		Usage:       "how to encode messages for the target, one of 'jetstream', 'json', 'cloudevents' or 'raw', which sends the message data unchanged and cannot be batched",
+		Value:       value,
+		Destination: &payloadFormat,
+		Action: func(c *cli.Context, v string) error {
+			if _, ok := payloadFormats[v]; !ok {
+				return fmt.Errorf("invalid payload format: %q", v)
+			}
+
+			return nil
+		},
+	}
+}
+
+func targetFlags() []cli.Flag {
+	return []cli.Flag{
 		&cli.StringFlag{
 			Name:        "lambda",
-			Usage:       "the ARN of the lambda to invoke",
-			Required:    true,
+			Usage:       "the name or ARN of the lambda to invoke",
 			Destination: &lambdaARN,
 		},
+		&cli.StringFlag{
+			Name:        "lambda-qualifier",
+			Usage:       "the version or alias of the lambda to invoke, $LATEST if unset",
+			Destination: &lambdaQualifier,
+		},
+		&cli.StringFlag{
+			Name:        "webhook",
+			Usage:       "the https URL to POST messages to",
+			Destination: &webhookURL,
+		},
+		&cli.StringSliceFlag{
+			Name:        "webhook-header",
+			Usage:       "a 'Name: value' header added to every webhook request, replacing the server's header of the same name",
+			Destination: &webhookHeaders,
+		},
+		&cli.StringFlag{
+			Name:        "webhook-signing-secret",
+			Usage:       "the secret to sign webhook requests with, the server's secret is used if unset",
+			Destination: &webhookSigningSecret,
+		},
+		&cli.DurationFlag{
+			Name:        "webhook-timeout",
+			Usage:       "how long to wait for the webhook to respond before retrying its messages, the server's timeout is used if unset",
+			Destination: &webhookTimeout,
+		},
+		&cli.BoolFlag{
+			Name:        "webhook-insecure",
+			Usage:       "allow the webhook URL to use plain http",
+			Destination: &webhookInsecure,
+		},
+		&cli.StringFlag{
+			Name:        "nats-subject",
+			Usage:       "the NATS subject to send messages to as requests, such as that of a NATS service",
+			Destination: &natsSubject,
+		},
+	}
+}
+
+// target returns the target given by the target flags, or nil if none is set.
+func target() (*v1.Target, error) {
+	var targets []*v1.Target
+	if lambdaARN != "" {
+		targets = append(targets, &v1.Target{Target: &v1.Target_Lambda{Lambda: &v1.LambdaTarget{
+			FunctionArn: lambdaARN,
+			Qualifier:   lambdaQualifier,
+		}}})
+	}
+
+	webhookConfigured := len(webhookHeaders.Value()) > 0 || webhookSigningSecret != "" || webhookTimeout != 0 || webhookInsecure
+	if webhookURL != "" {
+		webhook := &v1.WebhookTarget{
+			Url:           webhookURL,
+			SigningSecret: webhookSigningSecret,
+			Insecure:      webhookInsecure,
+		}
+
+		if len(webhookHeaders.Value()) > 0 {
+			webhook.Headers = make(map[string]string, len(webhookHeaders.Value()))
+			for _, header := range webhookHeaders.Value() {
+				name, value, ok := strings.Cut(header, ":")
+				name = strings.TrimSpace(name)
+				if !ok || name == "" {
+					return nil, fmt.Errorf("invalid webhook-header %q, expected Name: value", header)
+				}
+
+				webhook.Headers[name] = strings.TrimSpace(value)
+			}
+		}
+
+		if webhookTimeout != 0 {
+			webhook.Timeout = durationpb.New(webhookTimeout)
+		}
+
+		targets = append(targets, &v1.Target{Target: &v1.Target_Webhook{Webhook: webhook}})
+	}
+
+	if natsSubject != "" {
+		targets = append(targets, &v1.Target{Target: &v1.Target_Nats{Nats: &v1.NatsTarget{Subject: natsSubject}}})
+	}
+
+	switch {
+	case len(targets) > 1:
+		return nil, errors.New("only one of lambda, webhook and nats-subject may be set")
+	case lambdaQualifier != "" && lambdaARN == "":
+		return nil, errors.New("lambda-qualifier requires lambda to be set")
+	case webhookConfigured && webhookURL == "":
+		return nil, errors.New("webhook-header, webhook-signing-secret, webhook-timeout and webhook-insecure require webhook to be set")
+	case len(targets) == 1:
+		return targets[0], nil
+	default:
+		return nil, nil
+	}
+}
+
+func partitionKeyFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.UintFlag{
+			Name:        "partition-subject-token",
+			Usage:       "the 1-based index of the subject token to partition messages by, keeping only messages with the same token in order",
+			Destination: &partitionSubjectToken,
+		},
+		&cli.StringFlag{
+			Name:        "partition-header",
+			Usage:       "the message header to partition messages by, keeping only messages with the same header value in order",
+			Destination: &partitionHeader,
+		},
+	}
+}
+
+func partitionKey() (*v1.PartitionKey, error) {
+	switch {
+	case partitionSubjectToken > 0 && partitionHeader != "":
+		return nil, errors.New("only one of partition-subject-token and partition-header may be set")
+	case partitionSubjectToken > 0:
+		return &v1.PartitionKey{Key: &v1.PartitionKey_SubjectToken{SubjectToken: uint32(partitionSubjectToken)}}, nil
+	case partitionHeader != "":
+		return &v1.PartitionKey{Key: &v1.PartitionKey_Header{Header: partitionHeader}}, nil
+	default:
+		return &v1.PartitionKey{}, nil
+	}
+}
+
+func requiredLabelsFlag() *cli.StringSliceFlag {
+	return &cli.StringSliceFlag{
+		Name:        "required-label",
+		Usage:       "a key=value label that peers must have to run the binding, may be repeated",
+		Destination: &requiredLabels,
+	}
+}
+
+func parseRequiredLabels() (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, label := range requiredLabels.Value() {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid label %q, expected key=value", label)
+		}
+
+		labels[key] = value
+	}
+
+	return labels, nil
+}
+
+func retryPolicyFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:        "retry-initial-delay",
+			Usage:       "how long to wait before redelivering a failed message, 0 to redeliver immediately",
+			Destination: &retryInitialDelay,
+		},
+		&cli.Float64Flag{
+			Name:        "retry-multiplier",
+			Usage:       "the factor the retry delay grows by with each delivery of a message",
+			Value:       1,
+			Destination: &retryMultiplier,
+		},
+		&cli.DurationFlag{
+			Name:        "retry-max-delay",
+			Usage:       "the maximum delay before redelivering a failed message, 0 for no maximum",
+			Destination: &retryMaxDelay,
+		},
+		&cli.Float64Flag{
+			Name:        "retry-jitter",
+			Usage:       "the fraction (between 0 and 1) of each retry delay to randomly subtract",
+			Destination: &retryJitter,
+		},
+	}
+}
+
+func retryPolicy() *v1.RetryPolicy {
+	return &v1.RetryPolicy{
+		InitialDelay: durationpb.New(retryInitialDelay),
+		Multiplier:   retryMultiplier,
+		MaxDelay:     durationpb.New(retryMaxDelay),
+		Jitter:       retryJitter,
+	}
+}
+
+var BindingCreate = &cli.Command{
+	Name:    "create",
+	Aliases: []string{"c"},
+	Usage:   "create a new binding",
+	Flags: append(append(targetFlags(),
 		&cli.StringFlag{
 			Name:        "stream",
 			Usage:       "the stream to read from",
@@ -61,7 +320,7 @@ var BindingCreate = &cli.Command{
 		},
 		&cli.IntFlag{
 			Name:        "max-batch-size",
-			Usage:       "the maximum number of messages to batch together before sending to the lambda",
+			Usage:       "the maximum number of messages to batch together before sending to the target",
 			Required:    false,
 			Destination: &maxBatchSize,
 		},
@@ -78,7 +337,29 @@ var BindingCreate = &cli.Command{
 			Value:       "all",
 			Destination: &startFrom,
 		},
-	},
+		&cli.IntFlag{
+			Name:        "max-deliveries",
+			Usage:       "the maximum number of times a message is delivered before it is given up on, 0 for unlimited",
+			Required:    false,
+			Destination: &maxDeliveries,
+		},
+		&cli.StringFlag{
+			Name:        "dead-letter-subject",
+			Usage:       "the subject to publish messages to once they exceed max-deliveries",
+			Required:    false,
+			Destination: &deadLetterSubject,
+		},
+		&cli.IntFlag{
+			Name:        "max-concurrency",
+			Usage:       "the maximum number of batches to process in parallel, up to 256, messages are only processed in order when this is 1",
+			Required:    false,
+			Value:       1,
+			Destination: &maxConcurrency,
+		},
+		invocationTypeFlag("RequestResponse"),
+		payloadFormatFlag("jetstream"),
+		requiredLabelsFlag(),
+	), append(retryPolicyFlags(), partitionKeyFlags()...)...),
 	Action: func(c *cli.Context) error {
 		client := v1connect.NewJetbridgeServiceClient(http.DefaultClient, ServerURL)
 
@@ -86,13 +367,39 @@ var BindingCreate = &cli.Command{
 		defer cancel()
 
 		req := &v1.CreateBindingRequest{
-			LambdaArn:       lambdaARN,
-			Stream:          stream,
-			SubjectPattern:  subject,
-			MaxBatchSize:    int64(maxBatchSize),
-			MaxBatchLatency: durationpb.New(maxBatchLatency),
+			Stream:            stream,
+			SubjectPattern:    subject,
+			MaxBatchSize:      int64(maxBatchSize),
+			MaxBatchLatency:   durationpb.New(maxBatchLatency),
+			MaxDeliveries:     int64(maxDeliveries),
+			DeadLetterSubject: deadLetterSubject,
+			RetryPolicy:       retryPolicy(),
+			InvocationType:    invocationTypes[invocationType],
+			PayloadFormat:     payloadFormats[payloadFormat],
+			MaxConcurrency:    int64(maxConcurrency),
 		}
 
+		t, err := target()
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			return errors.New("one of lambda, webhook or nats-subject must be set")
+		}
+		req.Target = t
+
+		pk, err := partitionKey()
+		if err != nil {
+			return err
+		}
+		req.PartitionKey = pk
+
+		labels, err := parseRequiredLabels()
+		if err != nil {
+			return err
+		}
+		req.RequiredLabels = labels
+
 		switch startFrom {
 		case "all", "last", "last-per-subject", "new":
 			req.DeliveryPolicy = &v1.CreateBindingRequest_Policy{
@@ -145,6 +452,141 @@ var BindingList = &cli.Command{
 	},
 }
 
+var BindingUpdate = &cli.Command{
+	Name:      "update",
+	Aliases:   []string{"u"},
+	ArgsUsage: `ID of the binding to update.`,
+	Usage:     "update an existing binding, keeping its consumer position",
+	Flags: append(append(targetFlags(),
+		&cli.StringFlag{
+			Name:        "subject",
+			Usage:       "the subject pattern to apply to the consumer",
+			Destination: &subject,
+		},
+		&cli.IntFlag{
+			Name:        "max-batch-size",
+			Usage:       "the maximum number of messages to batch together before sending to the target",
+			Destination: &maxBatchSize,
+		},
+		&cli.DurationFlag{
+			Name:        "max-batch-latency",
+			Usage:       "the maximum amount of time to delay messages while waiting for the batch to fill up",
+			Destination: &maxBatchLatency,
+		},
+		&cli.IntFlag{
+			Name:        "max-deliveries",
+			Usage:       "the maximum number of times a message is delivered before it is given up on, 0 for unlimited",
+			Destination: &maxDeliveries,
+		},
+		&cli.StringFlag{
+			Name:        "dead-letter-subject",
+			Usage:       "the subject to publish messages to once they exceed max-deliveries",
+			Destination: &deadLetterSubject,
+		},
+		&cli.IntFlag{
+			Name:        "max-concurrency",
+			Usage:       "the maximum number of batches to process in parallel, up to 256, messages are only processed in order when this is 1",
+			Destination: &maxConcurrency,
+		},
+		invocationTypeFlag(""),
+		payloadFormatFlag(""),
+		requiredLabelsFlag(),
+		&cli.BoolFlag{
+			Name:  "clear-required-labels",
+			Usage: "let any peer run the binding, removing its required labels",
+		},
+	), append(retryPolicyFlags(), partitionKeyFlags()...)...),
+	Action: func(c *cli.Context) error {
+		client := v1connect.NewJetbridgeServiceClient(http.DefaultClient, ServerURL)
+
+		ctx, cancel := context.WithTimeout(c.Context, time.Minute)
+		defer cancel()
+
+		req := &v1.UpdateBindingRequest{
+			Id: c.Args().First(),
+		}
+
+		t, err := target()
+		if err != nil {
+			return err
+		}
+		req.Target = t
+
+		if c.IsSet("subject") {
+			req.SubjectPattern = &subject
+		}
+
+		if c.IsSet("max-batch-size") {
+			size := int64(maxBatchSize)
+			req.MaxBatchSize = &size
+		}
+
+		if c.IsSet("max-batch-latency") {
+			req.MaxBatchLatency = durationpb.New(maxBatchLatency)
+		}
+
+		if c.IsSet("max-deliveries") {
+			deliveries := int64(maxDeliveries)
+			req.MaxDeliveries = &deliveries
+		}
+
+		if c.IsSet("dead-letter-subject") {
+			req.DeadLetterSubject = &deadLetterSubject
+		}
+
+		if c.IsSet("max-concurrency") {
+			concurrency := int64(maxConcurrency)
+			req.MaxConcurrency = &concurrency
+		}
+
+		// Setting neither partition flag to a non-zero value disables partitioning
+		if c.IsSet("partition-subject-token") || c.IsSet("partition-header") {
+			pk, err := partitionKey()
+			if err != nil {
+				return err
+			}
+			req.PartitionKey = pk
+		}
+
+		// Required labels are replaced as a whole
+		switch {
+		case c.IsSet("required-label") && c.Bool("clear-required-labels"):
+			return errors.New("only one of required-label and clear-required-labels may be set")
+		case c.IsSet("required-label"):
+			labels, err := parseRequiredLabels()
+			if err != nil {
+				return err
+			}
+			req.RequiredLabels = &v1.Labels{Labels: labels}
+		case c.Bool("clear-required-labels"):
+			req.RequiredLabels = &v1.Labels{}
+		}
+
+		if c.IsSet("invocation-type") {
+			it := invocationTypes[invocationType]
+			req.InvocationType = &it
+		}
+
+		if c.IsSet("payload-format") {
+			pf := payloadFormats[payloadFormat]
+			req.PayloadFormat = &pf
+		}
+
+		// The retry policy is replaced as a whole, unset flags take their defaults
+		if c.IsSet("retry-initial-delay") || c.IsSet("retry-multiplier") || c.IsSet("retry-max-delay") || c.IsSet("retry-jitter") {
+			req.RetryPolicy = retryPolicy()
+		}
+
+		resp, err := client.UpdateBinding(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		prettyprint.Binding(resp.Msg.Binding)
+		return nil
+	},
+}
+
 var BindingDelete = &cli.Command{
 	Name:      "delete",
 	Aliases:   []string{"d"},
@@ -164,3 +606,64 @@ var BindingDelete = &cli.Command{
 		return nil
 	},
 }
+
+var BindingStatus = &cli.Command{
+	Name:      "status",
+	Aliases:   []string{"s"},
+	ArgsUsage: `ID of the binding to report on.`,
+	Usage:     "show the runtime status of a binding",
+	Action: func(c *cli.Context) error {
+		client := v1connect.NewJetbridgeServiceClient(http.DefaultClient, ServerURL)
+
+		ctx, cancel := context.WithTimeout(c.Context, time.Minute)
+		defer cancel()
+
+		resp, err := client.GetBindingStatus(ctx, connect.NewRequest(&v1.GetBindingStatusRequest{Id: c.Args().First()}))
+		if err != nil {
+			return err
+		}
+
+		prettyprint.BindingStatus(resp.Msg.Status)
+		return nil
+	},
+}
+
+var BindingPause = &cli.Command{
+	Name:      "pause",
+	ArgsUsage: `ID of the binding to pause.`,
+	Usage:     "stop delivering messages for a binding, keeping its consumer position",
+	Action: func(c *cli.Context) error {
+		client := v1connect.NewJetbridgeServiceClient(http.DefaultClient, ServerURL)
+
+		ctx, cancel := context.WithTimeout(c.Context, time.Minute)
+		defer cancel()
+
+		resp, err := client.PauseBinding(ctx, connect.NewRequest(&v1.PauseBindingRequest{Id: c.Args().First()}))
+		if err != nil {
+			return err
+		}
+
+		prettyprint.Binding(resp.Msg.Binding)
+		return nil
+	},
+}
+
+var BindingResume = &cli.Command{
+	Name:      "resume",
+	ArgsUsage: `ID of the binding to resume.`,
+	Usage:     "resume delivering messages for a paused binding",
+	Action: func(c *cli.Context) error {
+		client := v1connect.NewJetbridgeServiceClient(http.DefaultClient, ServerURL)
+
+		ctx, cancel := context.WithTimeout(c.Context, time.Minute)
+		defer cancel()
+
+		resp, err := client.ResumeBinding(ctx, connect.NewRequest(&v1.ResumeBindingRequest{Id: c.Args().First()}))
+		if err != nil {
+			return err
+		}
+
+		prettyprint.Binding(resp.Msg.Binding)
+		return nil
+	},
+}
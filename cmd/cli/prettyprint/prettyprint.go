@@ -1,9 +1,14 @@
 package prettyprint
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	v1 "github.com/JoeReid/jetbridge/proto/gen/go/jetbridge/v1"
 	"github.com/fatih/color"
 	"github.com/rodaine/table"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Binding(binding *v1.JetstreamBinding) {
@@ -11,15 +16,18 @@ func Binding(binding *v1.JetstreamBinding) {
 }
 
 func Bindings(bindings []*v1.JetstreamBinding) {
-	tbl := table.New("ID", "Lambda ARN", "Stream", "Subject", "Max Messages", "Max Latency", "Assigned Peer")
+	tbl := table.New("ID", "Target Type", "Target", "Stream", "Subject", "Max Messages", "Max Latency", "Max Deliveries", "Dead Letter Subject", "Invocation Type", "Payload Format", "Max Concurrency", "Partition By", "Paused", "Required Labels", "Assigned Peer")
 
 	tbl.WithHeaderFormatter(color.New(color.FgGreen, color.Underline).SprintfFunc())
 	tbl.WithFirstColumnFormatter(color.New(color.FgYellow).SprintfFunc())
 
 	for _, binding := range bindings {
+		targetType, target := target(binding.Target)
+
 		vals := []interface{}{
 			binding.Id,
-			binding.LambdaArn,
+			targetType,
+			target,
 			binding.Stream,
 			binding.SubjectPattern,
 		}
@@ -36,21 +44,120 @@ func Bindings(bindings []*v1.JetstreamBinding) {
 			vals = append(vals, binding.MaxBatchLatency.AsDuration())
 		}
 
-		vals = append(vals, binding.AssignedPeer)
+		if binding.MaxDeliveries == 0 {
+			vals = append(vals, "-")
+		} else {
+			vals = append(vals, binding.MaxDeliveries)
+		}
+
+		if binding.DeadLetterSubject == "" {
+			vals = append(vals, "-")
+		} else {
+			vals = append(vals, binding.DeadLetterSubject)
+		}
+
+		vals = append(vals, strings.TrimPrefix(binding.InvocationType.String(), "INVOCATION_TYPE_"))
+		vals = append(vals, strings.TrimPrefix(binding.PayloadFormat.String(), "PAYLOAD_FORMAT_"))
+
+		if binding.MaxConcurrency <= 1 {
+			vals = append(vals, "-")
+		} else {
+			vals = append(vals, binding.MaxConcurrency)
+		}
+
+		switch key := binding.PartitionKey.GetKey().(type) {
+		case *v1.PartitionKey_SubjectToken:
+			vals = append(vals, fmt.Sprintf("subject token %d", key.SubjectToken))
+		case *v1.PartitionKey_Header:
+			vals = append(vals, fmt.Sprintf("header %s", key.Header))
+		default:
+			vals = append(vals, "-")
+		}
+
+		vals = append(vals, binding.Paused, labels(binding.RequiredLabels), binding.AssignedPeer)
 
 		tbl.AddRow(vals...)
 	}
 	tbl.Print()
 }
 
+// target returns the type of a binding's target, and where it delivers to.
+func target(target *v1.Target) (string, string) {
+	switch t := target.GetTarget().(type) {
+	case *v1.Target_Lambda:
+		if t.Lambda.Qualifier != "" {
+			return "LAMBDA", t.Lambda.FunctionArn + ":" + t.Lambda.Qualifier
+		}
+		return "LAMBDA", t.Lambda.FunctionArn
+	case *v1.Target_Webhook:
+		return "WEBHOOK", t.Webhook.Url
+	case *v1.Target_Nats:
+		return "NATS", t.Nats.Subject
+	default:
+		return "-", "-"
+	}
+}
+
+func BindingStatus(status *v1.BindingStatus) {
+	tbl := table.New("ID", "Running", "Pending", "Ack Pending", "Redelivered", "Last Delivered", "Last Success", "Last Error", "Error Message")
+
+	tbl.WithHeaderFormatter(color.New(color.FgGreen, color.Underline).SprintfFunc())
+	tbl.WithFirstColumnFormatter(color.New(color.FgYellow).SprintfFunc())
+
+	vals := []interface{}{status.BindingId, status.Running}
+
+	if status.Consumer == nil {
+		vals = append(vals, "-", "-", "-", "-")
+	} else {
+		vals = append(vals,
+			status.Consumer.NumPending,
+			status.Consumer.NumAckPending,
+			status.Consumer.NumRedelivered,
+			status.Consumer.LastDeliveredStreamSequence,
+		)
+	}
+
+	for _, ts := range []*timestamppb.Timestamp{status.LastSuccess, status.LastError} {
+		if ts == nil {
+			vals = append(vals, "-")
+		} else {
+			vals = append(vals, ts.AsTime())
+		}
+	}
+
+	if status.LastErrorMessage == "" {
+		vals = append(vals, "-")
+	} else {
+		vals = append(vals, status.LastErrorMessage)
+	}
+
+	tbl.AddRow(vals...)
+	tbl.Print()
+}
+
 func Peers(peers []*v1.Peer) {
-	tbl := table.New("ID", "Hostname", "Joined At", "Last Seen", "Heartbeat Due By")
+	tbl := table.New("ID", "Hostname", "Weight", "Labels", "Joined At", "Last Seen", "Heartbeat Due By")
 
 	tbl.WithHeaderFormatter(color.New(color.FgGreen, color.Underline).SprintfFunc())
 	tbl.WithFirstColumnFormatter(color.New(color.FgYellow).SprintfFunc())
 
 	for _, peer := range peers {
-		tbl.AddRow(peer.Id, peer.Hostname, peer.Joined.AsTime(), peer.LastSeen.AsTime(), peer.HeartbeatDue.AsTime())
+		tbl.AddRow(peer.Id, peer.Hostname, peer.Weight, labels(peer.Labels), peer.Joined.AsTime(), peer.LastSeen.AsTime(), peer.HeartbeatDue.AsTime())
 	}
 	tbl.Print()
 }
+
+// labels formats labels as a sorted, comma separated list of key=value.
+func labels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "-"
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
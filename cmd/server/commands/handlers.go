@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	lambdarepo "github.com/JoeReid/jetbridge/repositories/lambda"
+	natsrepo "github.com/JoeReid/jetbridge/repositories/nats"
+	webhookrepo "github.com/JoeReid/jetbridge/repositories/webhook"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/nats-io/nats.go"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+)
+
+var (
+	webhookHeaders cli.StringSlice
+
+	webhookHeaderFlag = &cli.StringSliceFlag{
+		Name:        "webhook-header",
+		EnvVars:     []string{"WEBHOOK_HEADERS"},
+		Usage:       "A 'Name: value' header added to every webhook request, such as an Authorization header, unless the binding sets a header of the same name",
+		Destination: &webhookHeaders,
+	}
+)
+
+var (
+	webhookSigningSecret string
+
+	webhookSigningSecretFlag = &cli.StringFlag{
+		Name:        "webhook-signing-secret",
+		EnvVars:     []string{"WEBHOOK_SIGNING_SECRET"},
+		Usage:       "The secret to sign webhook requests with, sent in the Jetbridge-Signature header, requests are not signed if unset, used by bindings that do not set their own",
+		Destination: &webhookSigningSecret,
+	}
+)
+
+var (
+	webhookTimeout time.Duration
+
+	webhookTimeoutFlag = &cli.DurationFlag{
+		Name:        "webhook-timeout",
+		EnvVars:     []string{"WEBHOOK_TIMEOUT"},
+		Usage:       "How long to wait for a webhook to respond before retrying its messages, used by bindings that do not set their own",
+		Value:       webhookrepo.DefaultTimeout,
+		Destination: &webhookTimeout,
+	}
+)
+
+var (
+	natsRequestTimeout time.Duration
+
+	natsRequestTimeoutFlag = &cli.DurationFlag{
+		Name:        "nats-request-timeout",
+		EnvVars:     []string{"NATS_REQUEST_TIMEOUT"},
+		Usage:       "How long to wait for a NATS target to reply before retrying its messages",
+		Value:       natsrepo.DefaultRequestTimeout,
+		Destination: &natsRequestTimeout,
+	}
+)
+
+// newMessageHandlers builds the handler of each type of binding target,
+// configured by the webhook and NATS request flags.
+func newMessageHandlers(nc *nats.Conn, lambdaSvc lambdaiface.LambdaAPI, logger *zap.Logger) (repositories.MessageHandlers, error) {
+	headers, err := webhookrepo.ParseHeaders(webhookHeaders.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	lambdaHandler, err := lambdarepo.NewMessageHandler(lambdaSvc, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookHandler, err := webhookrepo.NewMessageHandler(webhookrepo.Config{
+		Headers:       headers,
+		SigningSecret: []byte(webhookSigningSecret),
+		Timeout:       webhookTimeout,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	natsHandler, err := natsrepo.NewMessageHandler(nc, natsRequestTimeout, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return repositories.MessageHandlers{
+		repositories.TargetLambda:  lambdaHandler,
+		repositories.TargetWebhook: webhookHandler,
+		repositories.TargetNATS:    natsHandler,
+	}, nil
+}
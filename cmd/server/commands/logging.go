@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	logLevel    string
+	logFormat   string
+	logSampling bool
+
+	logFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:        "log-level",
+			EnvVars:     []string{"LOG_LEVEL"},
+			Usage:       "The minimum level to log at, one of debug, info, warn or error",
+			Value:       "info",
+			Destination: &logLevel,
+		},
+		&cli.StringFlag{
+			Name:        "log-format",
+			EnvVars:     []string{"LOG_FORMAT"},
+			Usage:       "The format to write logs in, either json or console",
+			Value:       "json",
+			Destination: &logFormat,
+		},
+		&cli.BoolFlag{
+			Name:        "log-sampling",
+			EnvVars:     []string{"LOG_SAMPLING"},
+			Usage:       "Sample repeated log lines to limit the volume of logs under load",
+			Destination: &logSampling,
+		},
+	}
+)
+
+// newLogger builds the logger configured by the log flags.
+func newLogger() (*zap.Logger, error) {
+	level, err := zapcore.ParseLevel(logLevel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level: %w", err)
+	}
+
+	var config zap.Config
+	switch logFormat {
+	case "json":
+		config = zap.NewProductionConfig()
+	case "console":
+		config = zap.NewDevelopmentConfig()
+	default:
+		return nil, fmt.Errorf("invalid log format %q, must be json or console", logFormat)
+	}
+
+	config.Level = zap.NewAtomicLevelAt(level)
+	config.Development = false
+
+	if logSampling {
+		config.Sampling = &zap.SamplingConfig{
+			Initial:    100,
+			Thereafter: 100,
+		}
+	} else {
+		config.Sampling = nil
+	}
+
+	return config.Build()
+}
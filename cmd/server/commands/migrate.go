@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	dynamorepo "github.com/JoeReid/jetbridge/repositories/dynamo"
+	postgresrepo "github.com/JoeReid/jetbridge/repositories/postgres"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/guregu/dynamo"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
+)
+
+var MigrateCommand = &cli.Command{
+	Name:  "migrate",
+	Usage: "Create or update the PostgreSQL schema, or migrate the DynamoDB records, used to store internal state",
+	Flags: append([]cli.Flag{
+		stateBackendFlag,
+		dynamoEndpointFlag,
+		dynamoTableFlag,
+		postgresDSNFlag,
+	}, logFlags...),
+	Action: func(c *cli.Context) error {
+		logger, err := newLogger()
+		if err != nil {
+			return err
+		}
+		defer logger.Sync()
+
+		ctx, cancel := context.WithTimeout(c.Context, time.Minute)
+		defer cancel()
+
+		if !c.IsSet("state-backend") {
+			return errors.New("state-backend must be set")
+		}
+
+		switch stateBackend {
+		case stateBackendPostgres:
+			db, err := openPostgres()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return postgresrepo.Migrate(ctx, db)
+
+		case stateBackendDynamo:
+			awsSession, err := session.NewSession()
+			if err != nil {
+				return err
+			}
+
+			dynamoSvc := dynamo.New(awsSession, aws.NewConfig().WithEndpoint(dynamoEndpoint))
+
+			migrated, err := dynamorepo.MigrateBindings(ctx, dynamoSvc, dynamoTable)
+			if err != nil {
+				return err
+			}
+
+			logger.Info("migrated bindings", zap.Int("count", migrated))
+			return nil
+
+		default:
+			// KV records without a target are read as lambda targets, and are
+			// rewritten whenever they are updated
+			logger.Info("nothing to migrate", zap.String("state_backend", stateBackend))
+			return nil
+		}
+	},
+}
@@ -8,5 +8,6 @@ var Root = &cli.App{
 	Commands: []*cli.Command{
 		ServeCommand,
 		CreateTableCommand,
+		MigrateCommand,
 	},
 }
@@ -7,32 +7,47 @@ import (
 	"time"
 
 	"github.com/JoeReid/jetbridge/daemons"
+	"github.com/JoeReid/jetbridge/health"
+	"github.com/JoeReid/jetbridge/metrics"
 	"github.com/JoeReid/jetbridge/proto/gen/go/jetbridge/v1/v1connect"
-	dynamorepo "github.com/JoeReid/jetbridge/repositories/dynamo"
+	"github.com/JoeReid/jetbridge/repositories"
 	lambdarepo "github.com/JoeReid/jetbridge/repositories/lambda"
 	natsrepo "github.com/JoeReid/jetbridge/repositories/nats"
 	"github.com/JoeReid/jetbridge/server"
+	"github.com/JoeReid/jetbridge/tracing"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/bufbuild/connect-go"
 	grpchealth "github.com/bufbuild/connect-grpchealth-go"
 	"github.com/google/uuid"
-	"github.com/guregu/dynamo"
 	"github.com/nats-io/nats.go"
 	"github.com/urfave/cli/v2"
+	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
 var (
 	natsUrl  string
 	httpPort int
+
+	consumerGCInterval time.Duration
+	drainTimeout       time.Duration
+
+	verifyLambdaTargets bool
+
+	peerWeight float64
+	peerLabels cli.StringSlice
+
+	otlpEndpoint    string
+	otlpInsecure    bool
+	otlpServiceName string
 )
 
 var ServeCommand = &cli.Command{
 	Name:  "serve",
 	Usage: "Start the server",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		&cli.StringFlag{
 			Name:        "nats-url",
 			EnvVars:     []string{"NATS_URL"},
@@ -40,9 +55,23 @@ var ServeCommand = &cli.Command{
 			Value:       "nats://localhost:4222",
 			Destination: &natsUrl,
 		},
+		stateBackendFlag,
+		standaloneFlag,
 		dynamoEndpointFlag,
 		dynamoTableFlag,
+		kvBucketPrefixFlag,
+		postgresDSNFlag,
 		lambdaEndpointFlag,
+		&cli.BoolFlag{
+			Name:        "verify-lambda-targets",
+			EnvVars:     []string{"VERIFY_LAMBDA_TARGETS"},
+			Usage:       "Check that the function of a Lambda target exists before saving its binding, which requires the lambda:GetFunction permission",
+			Destination: &verifyLambdaTargets,
+		},
+		webhookHeaderFlag,
+		webhookSigningSecretFlag,
+		webhookTimeoutFlag,
+		natsRequestTimeoutFlag,
 		&cli.IntFlag{
 			Name:        "http-port",
 			EnvVars:     []string{"HTTP_PORT"},
@@ -50,8 +79,85 @@ var ServeCommand = &cli.Command{
 			Value:       8080,
 			Destination: &httpPort,
 		},
-	},
+		&cli.DurationFlag{
+			Name:        "consumer-gc-interval",
+			EnvVars:     []string{"CONSUMER_GC_INTERVAL"},
+			Usage:       "How often to delete the consumers created by this deployment that no longer have a binding, disabled if zero",
+			Destination: &consumerGCInterval,
+		},
+		&cli.DurationFlag{
+			Name:        "drain-timeout",
+			EnvVars:     []string{"DRAIN_TIMEOUT"},
+			Usage:       "How long a binding that is stopping, on shutdown or reassignment, is given to finish its in-flight batches",
+			Value:       30 * time.Second,
+			Destination: &drainTimeout,
+		},
+		&cli.Float64Flag{
+			Name:        "peer-weight",
+			EnvVars:     []string{"PEER_WEIGHT"},
+			Usage:       "The capacity of this peer, bindings are assigned to peers in proportion to their weight",
+			Value:       repositories.DefaultPeerWeight,
+			Destination: &peerWeight,
+		},
+		&cli.StringSliceFlag{
+			Name:        "peer-label",
+			EnvVars:     []string{"PEER_LABELS"},
+			Usage:       "A key=value label describing this peer, such as its region, only bindings requiring labels this peer has are assigned to it",
+			Destination: &peerLabels,
+		},
+		&cli.StringFlag{
+			Name:        "otlp-endpoint",
+			EnvVars:     []string{"OTLP_ENDPOINT"},
+			Usage:       "The host and port of an OTLP/HTTP collector to export traces to, traces are not exported if unset",
+			Destination: &otlpEndpoint,
+		},
+		&cli.BoolFlag{
+			Name:        "otlp-insecure",
+			EnvVars:     []string{"OTLP_INSECURE"},
+			Usage:       "Connect to the OTLP collector without TLS",
+			Destination: &otlpInsecure,
+		},
+		&cli.StringFlag{
+			Name:        "otlp-service-name",
+			EnvVars:     []string{"OTLP_SERVICE_NAME"},
+			Usage:       "The service name to report in exported traces",
+			Value:       "jetbridge",
+			Destination: &otlpServiceName,
+		},
+	}, logFlags...),
 	Action: func(c *cli.Context) error {
+		logger, err := newLogger()
+		if err != nil {
+			return err
+		}
+		defer logger.Sync()
+
+		if peerWeight <= 0 {
+			return fmt.Errorf("peer weight must be positive, got %v", peerWeight)
+		}
+
+		labels, err := repositories.ParseLabels(peerLabels.Value())
+		if err != nil {
+			return err
+		}
+
+		shutdownTracing, err := tracing.Setup(c.Context, tracing.Config{
+			Endpoint:    otlpEndpoint,
+			Insecure:    otlpInsecure,
+			ServiceName: otlpServiceName,
+		})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+
+			if err := shutdownTracing(ctx); err != nil {
+				logger.Error("failed to shutdown tracing", zap.Error(err))
+			}
+		}()
+
 		nc, err := nats.Connect(natsUrl)
 		if err != nil {
 			return err
@@ -68,31 +174,61 @@ var ServeCommand = &cli.Command{
 			return err
 		}
 
-		dynamoSvc := dynamo.New(awsSession, aws.NewConfig().WithEndpoint(dynamoEndpoint))
-
-		bindings, err := dynamorepo.NewBindings(dynamoSvc, dynamoTable)
+		state, err := newStateRepositories(awsSession, js)
 		if err != nil {
 			return err
 		}
+		defer state.Close()
 
-		peers, err := dynamorepo.NewPeers(dynamoSvc, dynamoTable)
+		consumers, err := natsrepo.NewConsumers(js, state.deployment)
 		if err != nil {
 			return err
 		}
 
 		lambdaSvc := lambda.New(awsSession, aws.NewConfig().WithEndpoint(lambdaEndpoint))
 
+		handlers, err := newMessageHandlers(nc, lambdaSvc, logger)
+		if err != nil {
+			return err
+		}
+
+		var targets repositories.TargetVerifier
+		if verifyLambdaTargets {
+			targets, err = lambdarepo.NewFunctionVerifier(lambdaSvc)
+			if err != nil {
+				return err
+			}
+		}
+
+		checker := health.NewChecker(v1connect.JetbridgeServiceName)
+		checker.AddProbe("nats", func(context.Context) error {
+			if status := nc.Status(); status != nats.CONNECTED {
+				return fmt.Errorf("connection is %s", status)
+			}
+			return nil
+		})
+
 		eg, ctx := errgroup.WithContext(c.Context)
 
 		eg.Go(func() error {
 			mux := http.NewServeMux()
 
 			mux.Handle(v1connect.NewJetbridgeServiceHandler(&server.V1{
-				Bindings: bindings,
-				Peers:    peers,
-			}, connect.WithInterceptors(connect.UnaryInterceptorFunc(server.LoggingInterceptor))))
+				Bindings:  state.bindings,
+				Peers:     state.peers,
+				Consumers: consumers,
+				Stats:     state.stats,
+				Targets:   targets,
+			}, connect.WithInterceptors(
+				connect.UnaryInterceptorFunc(server.TracingInterceptor),
+				server.LoggingInterceptor(logger),
+			)))
+
+			mux.Handle(grpchealth.NewHandler(checker))
+			mux.Handle("/healthz", checker.LivenessHandler())
+			mux.Handle("/readyz", checker.ReadinessHandler())
 
-			mux.Handle(grpchealth.NewHandler(grpchealth.NewStaticChecker(v1connect.JetbridgeServiceName)))
+			mux.Handle("/metrics", metrics.Handler())
 
 			server := &http.Server{
 				Addr:    fmt.Sprintf(":%d", httpPort),
@@ -111,21 +247,31 @@ var ServeCommand = &cli.Command{
 			return server.ListenAndServe()
 		})
 
+		switch {
+		case consumerGCInterval > 0 && standalone:
+			// A standalone peer knows nothing of the bindings of any other
+			// deployment, so must never delete consumers
+			logger.Warn("consumer-gc-interval is ignored when standalone")
+
+		case consumerGCInterval > 0:
+			eg.Go(func() error {
+				return daemons.NewConsumerGC(state.bindings, consumers, consumerGCInterval, logger).Run(ctx)
+			})
+		}
+
 		eg.Go(func() error {
-			membership, ctx := daemons.NewPeerMembership(ctx, peers)
+			membership, ctx := daemons.NewPeerMembership(ctx, state.peers, &repositories.JoinPeer{
+				Weight: peerWeight,
+				Labels: labels,
+			}, checker, logger)
 
 			membership.Go(func(peerID uuid.UUID) error {
-				source, err := natsrepo.NewMessageSource(js)
-				if err != nil {
-					return err
-				}
-
-				handler, err := lambdarepo.NewMessageHandler(lambdaSvc)
+				source, err := natsrepo.NewMessageSource(js, state.deployment, logger)
 				if err != nil {
 					return err
 				}
 
-				jsw, err := daemons.NewJetstreamWorker(bindings, source, handler)
+				jsw, err := daemons.NewJetstreamWorker(state.bindings, state.leases, source, handlers, state.stats, drainTimeout, checker, logger)
 				if err != nil {
 					return err
 				}
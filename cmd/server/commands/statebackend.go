@@ -0,0 +1,281 @@
+package commands
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	dynamorepo "github.com/JoeReid/jetbridge/repositories/dynamo"
+	memoryrepo "github.com/JoeReid/jetbridge/repositories/memory"
+	natskvrepo "github.com/JoeReid/jetbridge/repositories/natskv"
+	postgresrepo "github.com/JoeReid/jetbridge/repositories/postgres"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/guregu/dynamo"
+	"github.com/lib/pq"
+	"github.com/nats-io/nats.go"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	stateBackendDynamo   = "dynamo"
+	stateBackendKV       = "kv"
+	stateBackendPostgres = "postgres"
+)
+
+var (
+	stateBackend string
+
+	stateBackendFlag = &cli.StringFlag{
+		Name:        "state-backend",
+		EnvVars:     []string{"STATE_BACKEND"},
+		Usage:       "Where to store internal state, one of 'dynamo' for DynamoDB, 'kv' for NATS JetStream KV buckets or 'postgres' for PostgreSQL",
+		Value:       stateBackendDynamo,
+		Destination: &stateBackend,
+		Action: func(c *cli.Context, v string) error {
+			switch v {
+			case stateBackendDynamo, stateBackendKV, stateBackendPostgres:
+				return nil
+			default:
+				return fmt.Errorf("invalid state backend: %q", v)
+			}
+		},
+	}
+)
+
+var (
+	standalone bool
+
+	standaloneFlag = &cli.BoolFlag{
+		Name:        "standalone",
+		EnvVars:     []string{"STANDALONE"},
+		Usage:       "Run a single peer, keeping internal state in memory rather than an external state store, for local development and CI. Consumers are never garbage collected when standalone",
+		Destination: &standalone,
+		Action: func(c *cli.Context, v bool) error {
+			if v && c.IsSet("state-backend") {
+				return errors.New("only one of standalone and state-backend may be set")
+			}
+
+			return nil
+		},
+	}
+)
+
+var (
+	kvBucketPrefix string
+
+	kvBucketPrefixFlag = &cli.StringFlag{
+		Name:        "kv-bucket-prefix",
+		EnvVars:     []string{"KV_BUCKET_PREFIX"},
+		Usage:       "The prefix of the names of the NATS KV buckets used to store internal state, when using the 'kv' state backend",
+		Value:       "jetbridge",
+		Destination: &kvBucketPrefix,
+	}
+)
+
+var (
+	postgresDSN string
+
+	postgresDSNFlag = &cli.StringFlag{
+		Name:        "postgres-dsn",
+		EnvVars:     []string{"POSTGRES_DSN"},
+		Usage:       "The connection string of the PostgreSQL database used to store internal state, when using the 'postgres' state backend",
+		Destination: &postgresDSN,
+	}
+)
+
+// stateRepositories are the repositories storing internal state, shared by
+// all peers.
+type stateRepositories struct {
+	bindings repositories.Bindings
+	peers    repositories.Peers
+	leases   repositories.Leases
+	stats    repositories.Stats
+
+	// deployment identifies the state store, and so this deployment among
+	// any others sharing the NATS account. It tags the consumers created for
+	// bindings, so that only this deployment's consumers are ever swept.
+	deployment string
+
+	// close releases any connection to the state store
+	close func() error
+}
+
+func (s *stateRepositories) Close() error {
+	if s.close == nil {
+		return nil
+	}
+
+	return s.close()
+}
+
+// newStateRepositories opens the repositories of the selected state backend.
+func newStateRepositories(awsSession *session.Session, js nats.JetStreamContext) (*stateRepositories, error) {
+	if standalone {
+		return newMemoryStateRepositories(), nil
+	}
+
+	switch stateBackend {
+	case stateBackendKV:
+		return newKVStateRepositories(js)
+	case stateBackendPostgres:
+		return newPostgresStateRepositories()
+	default:
+		return newDynamoStateRepositories(awsSession)
+	}
+}
+
+func newDynamoStateRepositories(awsSession *session.Session) (*stateRepositories, error) {
+	dynamoSvc := dynamo.New(awsSession, aws.NewConfig().WithEndpoint(dynamoEndpoint))
+
+	bindings, err := dynamorepo.NewBindings(dynamoSvc, dynamoTable)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := dynamorepo.NewPeers(dynamoSvc, dynamoTable)
+	if err != nil {
+		return nil, err
+	}
+
+	leases, err := dynamorepo.NewLeases(dynamoSvc, dynamoTable)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := dynamorepo.NewStats(dynamoSvc, dynamoTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateRepositories{
+		bindings:   bindings,
+		peers:      peers,
+		leases:     leases,
+		stats:      stats,
+		deployment: "dynamo:" + dynamoTable,
+	}, nil
+}
+
+func newMemoryStateRepositories() *stateRepositories {
+	peers := memoryrepo.NewPeers()
+
+	return &stateRepositories{
+		bindings:   memoryrepo.NewBindings(peers),
+		peers:      peers,
+		leases:     memoryrepo.NewLeases(),
+		stats:      memoryrepo.NewStats(),
+		deployment: "standalone",
+	}
+}
+
+func newKVStateRepositories(js nats.JetStreamContext) (*stateRepositories, error) {
+	// Unlike the DynamoDB table, the buckets are cheap to create on demand
+	if err := natskvrepo.CreateBuckets(js, kvBucketPrefix); err != nil {
+		return nil, err
+	}
+
+	bindings, err := natskvrepo.NewBindings(js, kvBucketPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := natskvrepo.NewPeers(js, kvBucketPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	leases, err := natskvrepo.NewLeases(js, kvBucketPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := natskvrepo.NewStats(js, kvBucketPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateRepositories{
+		bindings:   bindings,
+		peers:      peers,
+		leases:     leases,
+		stats:      stats,
+		deployment: "kv:" + kvBucketPrefix,
+	}, nil
+}
+
+func newPostgresStateRepositories() (*stateRepositories, error) {
+	db, err := openPostgres()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := func() (*stateRepositories, error) {
+		bindings, err := postgresrepo.NewBindings(db)
+		if err != nil {
+			return nil, err
+		}
+
+		peers, err := postgresrepo.NewPeers(db)
+		if err != nil {
+			return nil, err
+		}
+
+		leases, err := postgresrepo.NewLeases(db)
+		if err != nil {
+			return nil, err
+		}
+
+		stats, err := postgresrepo.NewStats(db)
+		if err != nil {
+			return nil, err
+		}
+
+		return &stateRepositories{
+			bindings:   bindings,
+			peers:      peers,
+			leases:     leases,
+			stats:      stats,
+			deployment: postgresDeployment(postgresDSN),
+			close:      db.Close,
+		}, nil
+	}()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func openPostgres() (*sql.DB, error) {
+	if postgresDSN == "" {
+		return nil, errors.New("postgres-dsn must be set to use the postgres state backend")
+	}
+
+	db, err := sql.Open("postgres", postgresDSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres: %w", err)
+	}
+
+	return db, nil
+}
+
+// postgresDeployment identifies the database of the DSN by its host, port and
+// name, leaving out credentials and any other options.
+func postgresDeployment(dsn string) string {
+	if url, err := pq.ParseURL(dsn); err == nil {
+		dsn = url
+	}
+
+	options := make(map[string]string)
+	for _, field := range strings.Fields(dsn) {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			options[key] = strings.Trim(value, "'")
+		}
+	}
+
+	return fmt.Sprintf("postgres:%s:%s/%s", options["host"], options["port"], options["dbname"])
+}
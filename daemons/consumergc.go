@@ -0,0 +1,89 @@
+package daemons
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"go.uber.org/zap"
+)
+
+// ConsumerGC periodically deletes the consumers created by this deployment of
+// JetBridge that no longer have a binding, such as those left behind when
+// deleting a binding failed part way through. Consumers are only listed if
+// they are tagged with this deployment, so those of other deployments sharing
+// the NATS account are never deleted.
+type ConsumerGC struct {
+	logger    *zap.Logger
+	bindings  repositories.Bindings
+	consumers repositories.Consumers
+	interval  time.Duration
+}
+
+func NewConsumerGC(bindings repositories.Bindings, consumers repositories.Consumers, interval time.Duration, logger *zap.Logger) *ConsumerGC {
+	return &ConsumerGC{
+		logger:    logger.With(zap.String("component", "consumer_gc")),
+		bindings:  bindings,
+		consumers: consumers,
+		interval:  interval,
+	}
+}
+
+func (g *ConsumerGC) Run(ctx context.Context) error {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-ticker.C:
+			if err := g.sweep(ctx); err != nil {
+				g.logger.Error("failed to sweep consumers", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (g *ConsumerGC) sweep(ctx context.Context) error {
+	// Consumers must be listed before bindings. A consumer is only created once
+	// its binding exists, so any binding created in between is still seen.
+	consumers, err := g.consumers.ListConsumers(ctx)
+	if err != nil {
+		return err
+	}
+
+	bindings, err := g.bindings.ListJetstreamBindings(ctx)
+	if err != nil {
+		return err
+	}
+
+	inUse := make(map[repositories.Consumer]bool, len(bindings))
+	for _, binding := range bindings {
+		inUse[repositories.Consumer{Stream: binding.Stream, Name: binding.Consumer}] = true
+	}
+
+	for _, consumer := range consumers {
+		if inUse[consumer] {
+			continue
+		}
+
+		logger := g.logger.With(zap.String("stream", consumer.Stream), zap.String("consumer", consumer.Name.String()))
+
+		err := g.consumers.DeleteConsumer(ctx, consumer)
+		switch {
+		case errors.Is(err, repositories.ErrConsumerNotFound):
+			// Already deleted, likely by another peer's sweep
+
+		case err != nil:
+			logger.Error("failed to delete orphaned consumer", zap.Error(err))
+
+		default:
+			logger.Info("deleted orphaned consumer")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package daemons
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestConsumerGC_sweep(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bindingID   = uuid.New()
+		orphanID    = uuid.New()
+		deletedID   = uuid.New()
+		inUse       = repositories.Consumer{Stream: "test-stream", Name: bindingID}
+		orphan      = repositories.Consumer{Stream: "test-stream", Name: orphanID}
+		deleted     = repositories.Consumer{Stream: "other-stream", Name: deletedID}
+		otherStream = repositories.Consumer{Stream: "other-stream", Name: bindingID}
+	)
+
+	consumers := mocks.NewMockConsumers(ctrl)
+	bindings := mocks.NewMockBindings(ctrl)
+
+	// Bindings must be listed after consumers, so no new binding is missed
+	gomock.InOrder(
+		consumers.EXPECT().ListConsumers(gomock.Any()).Return([]repositories.Consumer{inUse, orphan, deleted, otherStream}, nil),
+		bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
+			{
+				ID:       bindingID,
+				Stream:   "test-stream",
+				Consumer: bindingID,
+			},
+		}, nil),
+	)
+
+	consumers.EXPECT().DeleteConsumer(gomock.Any(), orphan).Return(nil)
+	consumers.EXPECT().DeleteConsumer(gomock.Any(), deleted).Return(repositories.ErrConsumerNotFound)
+	consumers.EXPECT().DeleteConsumer(gomock.Any(), otherStream).Return(nil)
+
+	candidate := NewConsumerGC(bindings, consumers, 0, zap.NewNop())
+
+	err := candidate.sweep(context.TODO())
+	assert.NoError(t, err)
+}
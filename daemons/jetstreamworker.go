@@ -2,54 +2,101 @@ package daemons
 
 import (
 	"context"
-	"log"
+	"errors"
+	"reflect"
 	"sync"
 	"time"
 
+	"github.com/JoeReid/jetbridge/health"
+	"github.com/JoeReid/jetbridge/metrics"
 	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/tracing"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 )
 
 type jetstreamWorkerBinding struct {
-	binding repositories.JetstreamBinding
-	cancel  context.CancelFunc
+	binding      repositories.JetstreamBinding
+	stopFetching context.CancelFunc
+	abort        context.CancelFunc
+	done         chan struct{}
+	results      *bindingResults
+	lease        repositories.Lease
 }
 
-func NewJetstreamWorker(bindings repositories.Bindings, messages repositories.MessageSource, handler repositories.MessageHandler) (*JetstreamWorker, error) {
-	const (
-		defaultUpdateInterval = 5 * time.Second
-	)
+// bindingResults tracks the outcome of the most recent batches handled for a
+// binding, which the worker periodically records to the stats repository.
+type bindingResults struct {
+	mu            sync.Mutex
+	lastSuccessAt time.Time
+	lastErrorAt   time.Time
+	lastError     string
+}
+
+func (r *bindingResults) record(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	zl, err := zap.NewDevelopment() // TODO: this needs to be managed better
 	if err != nil {
-		return nil, err
+		r.lastErrorAt = time.Now()
+		r.lastError = err.Error()
+		return
 	}
 
+	r.lastSuccessAt = time.Now()
+}
+
+func NewJetstreamWorker(bindings repositories.Bindings, leases repositories.Leases, messages repositories.MessageSource, handler repositories.MessageHandler, stats repositories.Stats, drainTimeout time.Duration, checker *health.Checker, logger *zap.Logger) (*JetstreamWorker, error) {
+	const (
+		defaultUpdateInterval    = 5 * time.Second
+		defaultFetchErrorBackoff = time.Second
+	)
+
 	return &JetstreamWorker{
-		logger:         zl,
-		bindings:       bindings,
-		messages:       messages,
-		handler:        handler,
-		updateInterval: defaultUpdateInterval,
-		mu:             &sync.Mutex{},
-		workers:        make(map[string]jetstreamWorkerBinding),
+		logger:            logger.With(zap.String("component", "jetstream_worker")),
+		bindings:          bindings,
+		leases:            leases,
+		messages:          messages,
+		handler:           handler,
+		stats:             stats,
+		health:            checker,
+		updateInterval:    defaultUpdateInterval,
+		drainTimeout:      drainTimeout,
+		fetchErrorBackoff: defaultFetchErrorBackoff,
+		mu:                &sync.Mutex{},
+		workers:           make(map[string]jetstreamWorkerBinding),
 	}, nil
 }
 
 type JetstreamWorker struct {
 	logger         *zap.Logger
 	bindings       repositories.Bindings
+	leases         repositories.Leases
 	messages       repositories.MessageSource
 	handler        repositories.MessageHandler
+	stats          repositories.Stats
+	health         *health.Checker
 	updateInterval time.Duration
 
+	// drainTimeout is how long a stopped binding is given to finish the
+	// batches it is handling, before they are cancelled.
+	drainTimeout time.Duration
+
+	// fetchErrorBackoff is how long a pipeline waits after failing to fetch,
+	// such as while the consumer of a deleted binding is torn down.
+	fetchErrorBackoff time.Duration
+
 	mu      *sync.Mutex
 	workers map[string]jetstreamWorkerBinding
 }
 
 func (j *JetstreamWorker) Run(ctx context.Context, peerID uuid.UUID) error {
+	logger := j.logger.With(zap.String("peer_id", peerID.String()))
+
 	ticker := time.NewTicker(j.updateInterval)
 	defer ticker.Stop()
 
@@ -57,85 +104,207 @@ func (j *JetstreamWorker) Run(ctx context.Context, peerID uuid.UUID) error {
 	for {
 		select {
 		case <-ctx.Done():
-			for _, binding := range lastBindings {
-				j.removeBinding(ctx, binding)
-			}
+			j.releaseLeases(j.drainBindings(lastBindings))
 			return ctx.Err()
 
 		case <-ticker.C:
 			bindings, err := j.bindings.ListJetstreamBindings(ctx)
+			j.health.Report("bindings", err, health.WithTTL(3*j.updateInterval+j.drainTimeout))
 			if err != nil {
-				j.logger.Error("error listing bindings", zap.Error(err))
+				logger.Error("error listing bindings", zap.Error(err))
 				continue
 			}
 
 			var filteredBindings []repositories.JetstreamBinding
 			for _, binding := range bindings {
-				j.logger.Debug("checking binding", zap.Any("binding", binding))
+				logger.Debug("checking binding", zap.Any("binding", binding))
 
-				if binding.AssignedPeerID != nil && binding.AssignedPeerID.String() == peerID.String() {
-					j.logger.Debug("binding matches peer", zap.Any("binding", binding), zap.String("peer_id", peerID.String()))
+				// Paused bindings are treated as unassigned, stopping their worker
+				// while keeping their consumer
+				if binding.Paused {
+					logger.Debug("binding is paused", zap.Any("binding", binding))
+				} else if binding.AssignedPeerID != nil && binding.AssignedPeerID.String() == peerID.String() {
+					logger.Debug("binding matches peer", zap.Any("binding", binding))
 					filteredBindings = append(filteredBindings, binding)
 				} else {
-					j.logger.Debug("binding does not match peer", zap.Any("binding", binding), zap.String("peer_id", peerID.String()))
+					logger.Debug("binding does not match peer", zap.Any("binding", binding))
 				}
 			}
 
-			// remove bindings that are no longer assigned to this peer
+			// drain bindings that are no longer assigned to this peer, or that
+			// have been updated since their worker started, only releasing
+			// their lease once they have stopped
+			var stopping []repositories.JetstreamBinding
 			for _, binding := range lastBindings {
-				if !slices.ContainsFunc(filteredBindings, func(b repositories.JetstreamBinding) bool { return b.ID.String() == binding.ID.String() }) {
-					j.removeBinding(ctx, binding)
+				i := slices.IndexFunc(filteredBindings, func(b repositories.JetstreamBinding) bool { return b.ID.String() == binding.ID.String() })
+				if i < 0 || !reflect.DeepEqual(filteredBindings[i], binding) {
+					stopping = append(stopping, binding)
 				}
 			}
+			j.releaseLeases(j.drainBindings(stopping))
 
-			// add bindings that are now assigned to this peer
+			// abort bindings whose lease could not be renewed, as another peer
+			// may now hold it and be handling the same messages
+			j.abortBindings(j.renewLeases(ctx))
+
+			// start bindings that are now assigned to this peer, or restart
+			// those that were updated, once their lease is acquired
 			for _, binding := range filteredBindings {
-				if !slices.ContainsFunc(lastBindings, func(b repositories.JetstreamBinding) bool { return b.ID.String() == binding.ID.String() }) {
-					j.addBinding(ctx, binding)
+				if j.running(binding) {
+					continue
+				}
+
+				lease, err := j.leases.AcquireLease(ctx, binding.ID, peerID, j.leaseTTL())
+				switch {
+				case errors.Is(err, repositories.ErrLeaseHeld):
+					j.bindingLogger(binding).Debug("lease is held by another peer")
+				case err != nil:
+					j.bindingLogger(binding).Error("error acquiring lease", zap.Error(err))
+				default:
+					j.addBinding(ctx, binding, *lease)
 				}
 			}
 
-			lastBindings = filteredBindings
+			j.abortBindings(j.recordStats(ctx, peerID))
+
+			lastBindings = j.runningBindings()
 		}
 	}
 }
 
-func (j *JetstreamWorker) runBinding(ctx context.Context, binding repositories.JetstreamBinding) {
-	j.logger.Info(
-		"running binding",
-		zap.String("binding_id", binding.ID.String()),
-	)
+// runBinding runs the pipelines of a binding until they have all stopped. They
+// stop fetching once fetchCtx is done, finishing any batch they are handling
+// unless handleCtx is also done.
+func (j *JetstreamWorker) runBinding(fetchCtx, handleCtx context.Context, binding repositories.JetstreamBinding, results *bindingResults) {
+	// Each pipeline fetches and handles its own batches, with a concurrency of
+	// one the stream is processed strictly in order.
+	var wg sync.WaitGroup
+	for i := 0; i < binding.Concurrency(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			j.runPipeline(fetchCtx, handleCtx, binding, results)
+		}()
+	}
+	wg.Wait()
+}
+
+func (j *JetstreamWorker) runPipeline(fetchCtx, handleCtx context.Context, binding repositories.JetstreamBinding, results *bindingResults) {
+	logger := j.bindingLogger(binding)
 
 	for {
 		select {
-		case <-ctx.Done():
+		case <-fetchCtx.Done():
 			return
 
 		default:
-			j.logger.Info(
-				"fetching messages for binding",
-				zap.String("binding_id", binding.ID.String()),
-			)
+			logger.Debug("fetching messages for binding")
+
+			messages, err := j.messages.FetchJetstreamMessages(fetchCtx, binding)
+			if fetchCtx.Err() != nil {
+				// Draining, any messages fetched are handled before stopping
+				err = nil
+			}
 
-			messages, err := j.messages.FetchJetstreamMessages(ctx, binding)
+			j.health.Report(bindingHealthName(binding), err, health.WithSeverity(health.Informational))
 			if err != nil {
-				log.Println("error fetching messages", err)
+				logger.Error("error fetching messages", zap.Error(err))
+
+				select {
+				case <-fetchCtx.Done():
+				case <-time.After(j.fetchErrorBackoff):
+				}
+				continue
 			}
 
-			j.logger.Info(
-				"handling messages for binding",
-				zap.String("binding_id", binding.ID.String()),
-				zap.Int("messages", len(messages)),
-			)
+			if len(messages) == 0 {
+				continue
+			}
+
+			logger.Debug("handling messages for binding", zap.Int("messages", len(messages)))
+
+			if binding.PartitionKey.IsZero() {
+				j.handleBatch(handleCtx, binding, results, messages)
+				continue
+			}
 
-			if err := j.handler.HandleJetstreamMessages(ctx, binding, messages); err != nil {
-				log.Println("error handling messages", err)
+			// Dispatch each partition in parallel, waiting for them all before
+			// fetching again so that each key stays ordered across batches
+			var wg sync.WaitGroup
+			for _, partition := range partitionMessages(binding.PartitionKey, messages) {
+				wg.Add(1)
+				go func(partition []repositories.JetstreamMessage) {
+					defer wg.Done()
+					j.handleBatch(handleCtx, binding, results, partition)
+				}(partition)
 			}
+			wg.Wait()
 		}
 	}
 }
 
-func (j *JetstreamWorker) addBinding(ctx context.Context, binding repositories.JetstreamBinding) {
+// handleBatch dispatches a batch of messages to the handler within a span
+// linked to the trace context of each message. A batch of a single message
+// continues that message's trace directly.
+func (j *JetstreamWorker) handleBatch(ctx context.Context, binding repositories.JetstreamBinding, results *bindingResults, messages []repositories.JetstreamMessage) {
+	metrics.BatchSize.WithLabelValues(binding.ID.String()).Observe(float64(len(messages)))
+
+	opts := []trace.SpanStartOption{
+		trace.WithAttributes(
+			attribute.String("jetbridge.binding_id", binding.ID.String()),
+			attribute.Int("messaging.batch.message_count", len(messages)),
+		),
+	}
+
+	parent := ctx
+	for _, message := range messages {
+		msgCtx := tracing.Extract(ctx, message.Payload().Header)
+
+		if len(messages) == 1 {
+			parent = msgCtx
+		} else if sc := trace.SpanContextFromContext(msgCtx); sc.IsValid() {
+			opts = append(opts, trace.WithLinks(trace.Link{SpanContext: sc}))
+		}
+	}
+
+	ctx, span := tracing.Tracer().Start(parent, "jetbridge.batch", opts...)
+	defer span.End()
+
+	err := j.handler.HandleJetstreamMessages(ctx, binding, messages)
+	results.record(err)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		j.bindingLogger(binding).Error("error handling messages", zap.Error(err))
+	}
+}
+
+// partitionMessages splits messages by their partition key, preserving the
+// order of the messages within each partition.
+func partitionMessages(key repositories.PartitionKey, messages []repositories.JetstreamMessage) [][]repositories.JetstreamMessage {
+	var (
+		partitions [][]repositories.JetstreamMessage
+		index      = make(map[string]int)
+	)
+
+	for _, message := range messages {
+		k := key.Key(message.Payload())
+
+		i, ok := index[k]
+		if !ok {
+			i = len(partitions)
+			index[k] = i
+			partitions = append(partitions, nil)
+		}
+
+		partitions[i] = append(partitions[i], message)
+	}
+
+	return partitions
+}
+
+func (j *JetstreamWorker) addBinding(ctx context.Context, binding repositories.JetstreamBinding, lease repositories.Lease) {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
@@ -143,24 +312,271 @@ func (j *JetstreamWorker) addBinding(ctx context.Context, binding repositories.J
 		panic("binding already exists")
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
-	j.workers[binding.ID.String()] = jetstreamWorkerBinding{
-		binding: binding,
-		cancel:  cancel,
+	// Batches are handled in a context detached from the worker's, so that
+	// in-flight invocations can finish while the binding drains
+	fetchCtx, stopFetching := context.WithCancel(ctx)
+	handleCtx, abort := context.WithCancel(detach(ctx))
+
+	worker := jetstreamWorkerBinding{
+		binding:      binding,
+		stopFetching: stopFetching,
+		abort:        abort,
+		done:         make(chan struct{}),
+		results:      &bindingResults{},
+		lease:        lease,
 	}
+	j.workers[binding.ID.String()] = worker
 
-	go j.runBinding(ctx, binding)
+	j.bindingLogger(binding).Info(
+		"running binding",
+		zap.Int("concurrency", binding.Concurrency()),
+		zap.Int64("lease_token", lease.Token),
+	)
+
+	go func() {
+		defer close(worker.done)
+		j.runBinding(fetchCtx, handleCtx, binding, worker.results)
+	}()
 }
 
-func (j *JetstreamWorker) removeBinding(ctx context.Context, binding repositories.JetstreamBinding) {
+// drainBindings stops the workers of the given bindings, first letting them
+// finish the batches they are handling. Any still in flight after the drain
+// timeout are cancelled, and so returned to the stream by the handler. The
+// stopped workers are returned, still holding their leases.
+func (j *JetstreamWorker) drainBindings(bindings []repositories.JetstreamBinding) []jetstreamWorkerBinding {
+	if len(bindings) == 0 {
+		return nil
+	}
+
+	draining := j.removeWorkers(bindings)
+	for _, worker := range draining {
+		worker.stopFetching()
+	}
+
+	timeout := time.NewTimer(j.drainTimeout)
+	defer timeout.Stop()
+
+	expired := false
+	for _, worker := range draining {
+		if !expired {
+			select {
+			case <-worker.done:
+			case <-timeout.C:
+				expired = true
+			}
+		}
+
+		if expired {
+			select {
+			case <-worker.done:
+			default:
+				j.bindingLogger(worker.binding).Warn("drain timed out, cancelling in-flight batches", zap.Duration("drain_timeout", j.drainTimeout))
+			}
+		}
+
+		j.stopWorker(worker)
+	}
+
+	return draining
+}
+
+// abortBindings stops the workers of the given bindings without draining them,
+// cancelling the batches they are handling so that their messages are
+// redelivered. It is used once a binding's lease is lost, as the peer now
+// holding it may already be fetching the same messages.
+func (j *JetstreamWorker) abortBindings(bindings []repositories.JetstreamBinding) {
+	if len(bindings) == 0 {
+		return
+	}
+
+	aborting := j.removeWorkers(bindings)
+	for _, worker := range aborting {
+		worker.stopFetching()
+		worker.abort()
+	}
+
+	for _, worker := range aborting {
+		j.stopWorker(worker)
+	}
+}
+
+// removeWorkers removes the workers of the given bindings, so that they are no
+// longer considered to be running, and returns them to be stopped.
+func (j *JetstreamWorker) removeWorkers(bindings []repositories.JetstreamBinding) []jetstreamWorkerBinding {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
-	if b, ok := j.workers[binding.ID.String()]; ok {
-		b.cancel()
+	workers := make([]jetstreamWorkerBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		worker, ok := j.workers[binding.ID.String()]
+		if !ok {
+			panic("binding does not exist")
+		}
+
 		delete(j.workers, binding.ID.String())
-		return
+		workers = append(workers, worker)
 	}
 
-	panic("binding does not exist")
+	return workers
 }
+
+// stopWorker cancels any batches the worker is still handling, waits for it
+// to stop, then releases its subscriptions.
+func (j *JetstreamWorker) stopWorker(worker jetstreamWorkerBinding) {
+	worker.abort()
+	<-worker.done
+
+	j.messages.CloseJetstreamBinding(worker.binding)
+	j.health.Remove(bindingHealthName(worker.binding))
+}
+
+// leaseTTL is how long a lease is held for between renewals. It allows for a
+// few missed renewals, and for the binding to drain before the lease lapses.
+func (j *JetstreamWorker) leaseTTL() time.Duration {
+	return 3*j.updateInterval + j.drainTimeout
+}
+
+// renewLeases renews the lease of each running binding, returning those whose
+// lease has been lost. Failing to renew a lease for other reasons only loses
+// it once it expires.
+func (j *JetstreamWorker) renewLeases(ctx context.Context) []repositories.JetstreamBinding {
+	var lost []repositories.JetstreamBinding
+	for _, worker := range j.runningWorkers() {
+		logger := j.bindingLogger(worker.binding).With(zap.Int64("lease_token", worker.lease.Token))
+
+		lease, err := j.leases.RenewLease(ctx, worker.lease, j.leaseTTL())
+		switch {
+		case errors.Is(err, repositories.ErrLeaseLost):
+			logger.Warn("lease was lost, stopping binding")
+			lost = append(lost, worker.binding)
+
+		case err != nil && time.Now().After(worker.lease.ExpiresAt):
+			logger.Error("lease expired before it could be renewed, stopping binding", zap.Error(err))
+			lost = append(lost, worker.binding)
+
+		case err != nil:
+			logger.Warn("error renewing lease", zap.Error(err))
+
+		default:
+			j.mu.Lock()
+			worker.lease = *lease
+			j.workers[worker.binding.ID.String()] = worker
+			j.mu.Unlock()
+		}
+	}
+
+	return lost
+}
+
+// releaseLeases releases the leases of stopped workers, so that the peer now
+// assigned their binding need not wait for the leases to expire.
+func (j *JetstreamWorker) releaseLeases(workers []jetstreamWorkerBinding) {
+	// The worker's context may be done if it is shutting down
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, worker := range workers {
+		if err := j.leases.ReleaseLease(ctx, worker.lease); err != nil {
+			j.bindingLogger(worker.binding).Warn("failed to release lease", zap.Int64("lease_token", worker.lease.Token), zap.Error(err))
+		}
+	}
+}
+
+// runningWorkers returns a snapshot of the bindings being run.
+func (j *JetstreamWorker) runningWorkers() []jetstreamWorkerBinding {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	workers := make([]jetstreamWorkerBinding, 0, len(j.workers))
+	for _, worker := range j.workers {
+		workers = append(workers, worker)
+	}
+	return workers
+}
+
+func (j *JetstreamWorker) runningBindings() []repositories.JetstreamBinding {
+	workers := j.runningWorkers()
+
+	bindings := make([]repositories.JetstreamBinding, len(workers))
+	for i, worker := range workers {
+		bindings[i] = worker.binding
+	}
+	return bindings
+}
+
+func (j *JetstreamWorker) running(binding repositories.JetstreamBinding) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	_, ok := j.workers[binding.ID.String()]
+	return ok
+}
+
+// recordStats records the stats of each running binding, marking them as
+// running until a few update intervals from now. Bindings the worker stops
+// running are no longer refreshed, and so lapse. It returns the bindings whose
+// stats were rejected, as another peer has since acquired their lease.
+func (j *JetstreamWorker) recordStats(ctx context.Context, peerID uuid.UUID) []repositories.JetstreamBinding {
+	var lost []repositories.JetstreamBinding
+
+	now := time.Now()
+	for _, worker := range j.runningWorkers() {
+		worker.results.mu.Lock()
+		stats := &repositories.BindingStats{
+			BindingID:     worker.binding.ID,
+			PeerID:        peerID,
+			LeaseToken:    worker.lease.Token,
+			RecordedAt:    now,
+			RunningUntil:  now.Add(3 * j.updateInterval),
+			LastSuccessAt: worker.results.lastSuccessAt,
+			LastErrorAt:   worker.results.lastErrorAt,
+			LastError:     worker.results.lastError,
+		}
+		worker.results.mu.Unlock()
+
+		err := j.stats.RecordBindingStats(ctx, stats)
+		switch {
+		case errors.Is(err, repositories.ErrLeaseLost):
+			j.bindingLogger(worker.binding).Warn("lease was lost, stopping binding", zap.Int64("lease_token", worker.lease.Token))
+			lost = append(lost, worker.binding)
+
+		case err != nil:
+			j.bindingLogger(worker.binding).Warn("failed to record binding stats", zap.Error(err))
+		}
+	}
+
+	return lost
+}
+
+// bindingLogger returns a logger annotated with the fields identifying a binding.
+func (j *JetstreamWorker) bindingLogger(binding repositories.JetstreamBinding) *zap.Logger {
+	fields := []zap.Field{
+		zap.String("binding_id", binding.ID.String()),
+		zap.String("stream", binding.Stream),
+	}
+
+	if binding.AssignedPeerID != nil {
+		fields = append(fields, zap.String("peer_id", binding.AssignedPeerID.String()))
+	}
+
+	return j.logger.With(fields...)
+}
+
+// bindingHealthName is the name a binding's worker reports its health under.
+// A single failing binding does not affect the health of the server.
+func bindingHealthName(binding repositories.JetstreamBinding) string {
+	return "binding/" + binding.ID.String()
+}
+
+// detachedContext carries the values of its parent, but not its cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func detach(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
@@ -2,15 +2,20 @@ package daemons
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/health"
 	"github.com/JoeReid/jetbridge/repositories"
 	"github.com/JoeReid/jetbridge/repositories/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestJetstreamWorker(t *testing.T) {
@@ -28,7 +33,7 @@ func TestJetstreamWorker(t *testing.T) {
 	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
 		{
 			ID:             bindingID,
-			LambdaARN:      "test-arn",
+			Target:         repositories.Target{URI: "test-arn"},
 			Stream:         "test-stream",
 			Consumer:       bindingID,
 			Subject:        "test-stream.*",
@@ -39,14 +44,34 @@ func TestJetstreamWorker(t *testing.T) {
 	}, nil).Times(4)
 
 	msg := mocks.NewMockJetstreamMessage(ctrl)
+	msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{}).AnyTimes()
 
 	source := mocks.NewMockMessageSource(ctrl)
 	source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return([]repositories.JetstreamMessage{msg}, nil).AnyTimes()
+	source.EXPECT().CloseJetstreamBinding(gomock.Any()).AnyTimes()
 
 	handler := mocks.NewMockMessageHandler(ctrl)
 	handler.EXPECT().HandleJetstreamMessages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-	candidate, err := NewJetstreamWorker(bindings, source, handler)
+	checker := health.NewChecker()
+
+	var (
+		statsMu  sync.Mutex
+		recorded *repositories.BindingStats
+	)
+
+	stats := mocks.NewMockStats(ctrl)
+	stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, s *repositories.BindingStats) error {
+			statsMu.Lock()
+			defer statsMu.Unlock()
+
+			recorded = s
+			return nil
+		},
+	).AnyTimes()
+
+	candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, time.Second, checker, zap.NewNop())
 	require.NoError(t, err)
 	candidate.updateInterval = time.Second
 
@@ -55,4 +80,603 @@ func TestJetstreamWorker(t *testing.T) {
 
 	err = candidate.Run(ctx, peerID)
 	assert.ErrorContains(t, err, "context deadline exceeded")
+
+	result := checker.Evaluate(context.TODO(), health.Readiness)
+	assert.True(t, result.Healthy)
+	assert.Equal(t, "ok", result.Components["bindings"])
+	assert.NotContains(t, result.Components, "binding/"+bindingID.String(), "stopped workers should no longer report")
+
+	statsMu.Lock()
+	defer statsMu.Unlock()
+
+	require.NotNil(t, recorded)
+	assert.Equal(t, bindingID, recorded.BindingID)
+	assert.Equal(t, int64(1), recorded.LeaseToken)
+	assert.True(t, recorded.Running(&peerID, recorded.RecordedAt))
+	assert.False(t, recorded.LastSuccessAt.IsZero())
+	assert.True(t, recorded.LastErrorAt.IsZero())
+}
+
+func TestJetstreamWorker_updatedBinding(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		peerID    = uuid.New()
+		bindingID = uuid.New()
+	)
+
+	binding := repositories.JetstreamBinding{
+		ID:             bindingID,
+		Target:         repositories.Target{URI: "test-arn"},
+		Stream:         "test-stream",
+		Consumer:       bindingID,
+		Subject:        "test-stream.*",
+		MaxMessages:    10,
+		MaxLatency:     time.Second,
+		AssignedPeerID: &peerID,
+	}
+
+	updatedBinding := binding
+	updatedBinding.Target.URI = "updated-test-arn"
+
+	bindings := mocks.NewMockBindings(ctrl)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{binding}, nil).Times(1)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{updatedBinding}, nil).AnyTimes()
+
+	msg := mocks.NewMockJetstreamMessage(ctrl)
+	msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{}).AnyTimes()
+
+	source := mocks.NewMockMessageSource(ctrl)
+	source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return([]repositories.JetstreamMessage{msg}, nil).AnyTimes()
+	source.EXPECT().CloseJetstreamBinding(gomock.Any()).AnyTimes()
+
+	var mu sync.Mutex
+	handled := make(map[string]bool)
+
+	handler := mocks.NewMockMessageHandler(ctrl)
+	handler.EXPECT().HandleJetstreamMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, b repositories.JetstreamBinding, _ []repositories.JetstreamMessage) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			handled[b.Target.URI] = true
+			return nil
+		},
+	).AnyTimes()
+
+	stats := mocks.NewMockStats(ctrl)
+	stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, time.Second, nil, zap.NewNop())
+	require.NoError(t, err)
+	candidate.updateInterval = time.Second
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*2500)
+	defer cancel()
+
+	err = candidate.Run(ctx, peerID)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.True(t, handled["test-arn"])
+	assert.True(t, handled["updated-test-arn"])
+}
+
+func TestJetstreamWorker_concurrency(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		peerID    = uuid.New()
+		bindingID = uuid.New()
+	)
+
+	bindings := mocks.NewMockBindings(ctrl)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
+		{
+			ID:             bindingID,
+			Target:         repositories.Target{URI: "test-arn"},
+			Stream:         "test-stream",
+			Consumer:       bindingID,
+			Subject:        "test-stream.*",
+			MaxMessages:    10,
+			MaxLatency:     time.Second,
+			AssignedPeerID: &peerID,
+			MaxConcurrency: 3,
+		},
+	}, nil).AnyTimes()
+
+	msg := mocks.NewMockJetstreamMessage(ctrl)
+	msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{}).AnyTimes()
+
+	source := mocks.NewMockMessageSource(ctrl)
+	source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return([]repositories.JetstreamMessage{msg}, nil).AnyTimes()
+	source.EXPECT().CloseJetstreamBinding(gomock.Any()).AnyTimes()
+
+	var (
+		mu                  sync.Mutex
+		inFlight, maxFlight int
+	)
+
+	handler := mocks.NewMockMessageHandler(ctrl)
+	handler.EXPECT().HandleJetstreamMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, repositories.JetstreamBinding, []repositories.JetstreamMessage) error {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxFlight {
+				maxFlight = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(100 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		},
+	).AnyTimes()
+
+	stats := mocks.NewMockStats(ctrl)
+	stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, time.Second, nil, zap.NewNop())
+	require.NoError(t, err)
+	candidate.updateInterval = time.Second
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*1500)
+	defer cancel()
+
+	err = candidate.Run(ctx, peerID)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 3, maxFlight)
+}
+
+func TestJetstreamWorker_partitioned(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		peerID    = uuid.New()
+		bindingID = uuid.New()
+	)
+
+	bindings := mocks.NewMockBindings(ctrl)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
+		{
+			ID:             bindingID,
+			Target:         repositories.Target{URI: "test-arn"},
+			Stream:         "test-stream",
+			Consumer:       bindingID,
+			Subject:        "orders.*.*",
+			MaxMessages:    10,
+			MaxLatency:     time.Second,
+			AssignedPeerID: &peerID,
+			PartitionKey:   repositories.PartitionKey{SubjectToken: 2},
+		},
+	}, nil).AnyTimes()
+
+	var messages []repositories.JetstreamMessage
+	for _, subject := range []string{"orders.a.1", "orders.b.1", "orders.a.2", "orders.c.1", "orders.b.2"} {
+		msg := mocks.NewMockJetstreamMessage(ctrl)
+		msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{Subject: subject}).AnyTimes()
+		messages = append(messages, msg)
+	}
+
+	source := mocks.NewMockMessageSource(ctrl)
+	source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return(messages, nil).AnyTimes()
+	source.EXPECT().CloseJetstreamBinding(gomock.Any()).AnyTimes()
+
+	var (
+		mu      sync.Mutex
+		handled = make(map[string][]string)
+	)
+
+	handler := mocks.NewMockMessageHandler(ctrl)
+	handler.EXPECT().HandleJetstreamMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ repositories.JetstreamBinding, partition []repositories.JetstreamMessage) error {
+			var subjects []string
+			for _, msg := range partition {
+				subjects = append(subjects, msg.Payload().Subject)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			handled[strings.Split(subjects[0], ".")[1]] = subjects
+			return nil
+		},
+	).AnyTimes()
+
+	stats := mocks.NewMockStats(ctrl)
+	stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, time.Second, nil, zap.NewNop())
+	require.NoError(t, err)
+	candidate.updateInterval = time.Second
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*1500)
+	defer cancel()
+
+	err = candidate.Run(ctx, peerID)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, map[string][]string{
+		"a": {"orders.a.1", "orders.a.2"},
+		"b": {"orders.b.1", "orders.b.2"},
+		"c": {"orders.c.1"},
+	}, handled)
+}
+
+func TestJetstreamWorker_paused(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		peerID    = uuid.New()
+		bindingID = uuid.New()
+	)
+
+	bindings := mocks.NewMockBindings(ctrl)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
+		{
+			ID:             bindingID,
+			Target:         repositories.Target{URI: "test-arn"},
+			Stream:         "test-stream",
+			Consumer:       bindingID,
+			Subject:        "test-stream.*",
+			AssignedPeerID: &peerID,
+			Paused:         true,
+		},
+	}, nil).AnyTimes()
+
+	// No expectations, paused bindings must not be fetched, handled or reported
+	source := mocks.NewMockMessageSource(ctrl)
+	handler := mocks.NewMockMessageHandler(ctrl)
+	stats := mocks.NewMockStats(ctrl)
+
+	candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, time.Second, nil, zap.NewNop())
+	require.NoError(t, err)
+	candidate.updateInterval = 100 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*500)
+	defer cancel()
+
+	err = candidate.Run(ctx, peerID)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+}
+
+func TestJetstreamWorker_removedBinding(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		peerID    = uuid.New()
+		bindingID = uuid.New()
+	)
+
+	binding := repositories.JetstreamBinding{
+		ID:             bindingID,
+		Target:         repositories.Target{URI: "test-arn"},
+		Stream:         "test-stream",
+		Consumer:       bindingID,
+		Subject:        "test-stream.*",
+		AssignedPeerID: &peerID,
+	}
+
+	bindings := mocks.NewMockBindings(ctrl)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{binding}, nil).Times(1)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	source := mocks.NewMockMessageSource(ctrl)
+	source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	source.EXPECT().CloseJetstreamBinding(binding).Times(1)
+
+	handler := mocks.NewMockMessageHandler(ctrl)
+
+	stats := mocks.NewMockStats(ctrl)
+	stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, time.Second, nil, zap.NewNop())
+	require.NoError(t, err)
+	candidate.updateInterval = 100 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*500)
+	defer cancel()
+
+	err = candidate.Run(ctx, peerID)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+}
+
+func TestJetstreamWorker_drain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		drainTimeout time.Duration
+		cancelled    bool
+	}{
+		{name: "in-flight batch finishes", drainTimeout: 5 * time.Second, cancelled: false},
+		{name: "drain times out", drainTimeout: 100 * time.Millisecond, cancelled: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				peerID    = uuid.New()
+				bindingID = uuid.New()
+			)
+
+			bindings := mocks.NewMockBindings(ctrl)
+			bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
+				{
+					ID:             bindingID,
+					Target:         repositories.Target{URI: "test-arn"},
+					Stream:         "test-stream",
+					Consumer:       bindingID,
+					Subject:        "test-stream.*",
+					AssignedPeerID: &peerID,
+				},
+			}, nil).AnyTimes()
+
+			msg := mocks.NewMockJetstreamMessage(ctrl)
+			msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{}).AnyTimes()
+
+			source := mocks.NewMockMessageSource(ctrl)
+			source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return([]repositories.JetstreamMessage{msg}, nil).Times(1)
+			source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ repositories.JetstreamBinding) ([]repositories.JetstreamMessage, error) {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				},
+			).AnyTimes()
+			source.EXPECT().CloseJetstreamBinding(gomock.Any()).Times(1)
+
+			var (
+				mu        sync.Mutex
+				finished  bool
+				handleErr error
+			)
+
+			handler := mocks.NewMockMessageHandler(ctrl)
+			handler.EXPECT().HandleJetstreamMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ repositories.JetstreamBinding, _ []repositories.JetstreamMessage) error {
+					// The batch is still in flight when the worker is stopped
+					select {
+					case <-ctx.Done():
+					case <-time.After(time.Second):
+					}
+
+					mu.Lock()
+					defer mu.Unlock()
+
+					finished = true
+					handleErr = ctx.Err()
+					return handleErr
+				},
+			).Times(1)
+
+			stats := mocks.NewMockStats(ctrl)
+			stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			candidate, err := NewJetstreamWorker(bindings, testLeases(ctrl), source, handler, stats, tt.drainTimeout, nil, zap.NewNop())
+			require.NoError(t, err)
+			candidate.updateInterval = 100 * time.Millisecond
+
+			ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*500)
+			defer cancel()
+
+			err = candidate.Run(ctx, peerID)
+			assert.ErrorContains(t, err, "context deadline exceeded")
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			assert.True(t, finished, "the worker should not stop until the batch is finished")
+			if tt.cancelled {
+				assert.ErrorIs(t, handleErr, context.Canceled)
+			} else {
+				assert.NoError(t, handleErr)
+			}
+		})
+	}
+}
+
+func TestJetstreamWorker_leaseHeld(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		peerID    = uuid.New()
+		bindingID = uuid.New()
+	)
+
+	bindings := mocks.NewMockBindings(ctrl)
+	bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{
+		{
+			ID:             bindingID,
+			Target:         repositories.Target{URI: "test-arn"},
+			Stream:         "test-stream",
+			Consumer:       bindingID,
+			Subject:        "test-stream.*",
+			AssignedPeerID: &peerID,
+		},
+	}, nil).AnyTimes()
+
+	// Another peer has yet to release the binding
+	leases := mocks.NewMockLeases(ctrl)
+	leases.EXPECT().AcquireLease(gomock.Any(), bindingID, peerID, gomock.Any()).Return(nil, repositories.ErrLeaseHeld).MinTimes(1)
+
+	// No expectations, the binding must not be run without its lease
+	source := mocks.NewMockMessageSource(ctrl)
+	handler := mocks.NewMockMessageHandler(ctrl)
+	stats := mocks.NewMockStats(ctrl)
+
+	candidate, err := NewJetstreamWorker(bindings, leases, source, handler, stats, time.Second, nil, zap.NewNop())
+	require.NoError(t, err)
+	candidate.updateInterval = 100 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*500)
+	defer cancel()
+
+	err = candidate.Run(ctx, peerID)
+	assert.ErrorContains(t, err, "context deadline exceeded")
+}
+
+func TestJetstreamWorker_leaseLost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// renewErr and statsErr are returned on the second update, once the
+		// binding is running
+		renewErr error
+		statsErr error
+	}{
+		{name: "renewal rejected", renewErr: repositories.ErrLeaseLost},
+		{name: "stats rejected", statsErr: repositories.ErrLeaseLost},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				peerID    = uuid.New()
+				bindingID = uuid.New()
+			)
+
+			binding := repositories.JetstreamBinding{
+				ID:             bindingID,
+				Target:         repositories.Target{URI: "test-arn"},
+				Stream:         "test-stream",
+				Consumer:       bindingID,
+				Subject:        "test-stream.*",
+				AssignedPeerID: &peerID,
+			}
+
+			bindings := mocks.NewMockBindings(ctrl)
+			bindings.EXPECT().ListJetstreamBindings(gomock.Any()).Return([]repositories.JetstreamBinding{binding}, nil).AnyTimes()
+
+			lease := repositories.Lease{BindingID: bindingID, PeerID: peerID, Token: 1, ExpiresAt: time.Now().Add(time.Minute)}
+
+			// The lease is acquired by another peer after the binding starts, it
+			// must be stopped without releasing the other peer's lease
+			leases := mocks.NewMockLeases(ctrl)
+			gomock.InOrder(
+				leases.EXPECT().AcquireLease(gomock.Any(), bindingID, peerID, gomock.Any()).Return(&lease, nil),
+				leases.EXPECT().RenewLease(gomock.Any(), lease, gomock.Any()).Return(&lease, tt.renewErr),
+				leases.EXPECT().AcquireLease(gomock.Any(), bindingID, peerID, gomock.Any()).Return(nil, repositories.ErrLeaseHeld).AnyTimes(),
+			)
+
+			msg := mocks.NewMockJetstreamMessage(ctrl)
+			msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{}).AnyTimes()
+
+			source := mocks.NewMockMessageSource(ctrl)
+			source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).Return([]repositories.JetstreamMessage{msg}, nil).Times(1)
+			source.EXPECT().FetchJetstreamMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ repositories.JetstreamBinding) ([]repositories.JetstreamMessage, error) {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				},
+			).AnyTimes()
+			source.EXPECT().CloseJetstreamBinding(binding).Times(1)
+
+			var (
+				mu        sync.Mutex
+				handleErr error
+			)
+
+			// The batch is still in flight when the lease is lost, it must be
+			// cancelled rather than drained, as the other peer may also handle it
+			handler := mocks.NewMockMessageHandler(ctrl)
+			handler.EXPECT().HandleJetstreamMessages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ repositories.JetstreamBinding, _ []repositories.JetstreamMessage) error {
+					select {
+					case <-ctx.Done():
+					case <-time.After(time.Second):
+					}
+
+					mu.Lock()
+					defer mu.Unlock()
+
+					handleErr = ctx.Err()
+					return handleErr
+				},
+			).Times(1)
+
+			stats := mocks.NewMockStats(ctrl)
+			gomock.InOrder(
+				stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(nil),
+				stats.EXPECT().RecordBindingStats(gomock.Any(), gomock.Any()).Return(tt.statsErr).MaxTimes(1),
+			)
+
+			candidate, err := NewJetstreamWorker(bindings, leases, source, handler, stats, 5*time.Second, nil, zap.NewNop())
+			require.NoError(t, err)
+			candidate.updateInterval = 100 * time.Millisecond
+
+			ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond*500)
+			defer cancel()
+
+			err = candidate.Run(ctx, peerID)
+			assert.ErrorContains(t, err, "context deadline exceeded")
+			assert.Empty(t, candidate.runningBindings())
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			assert.ErrorIs(t, handleErr, context.Canceled)
+		})
+	}
+}
+
+// testLeases returns leases that are always granted to the peer asking.
+func testLeases(ctrl *gomock.Controller) *mocks.MockLeases {
+	leases := mocks.NewMockLeases(ctrl)
+	leases.EXPECT().AcquireLease(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, bindingID, peerID uuid.UUID, ttl time.Duration) (*repositories.Lease, error) {
+			return &repositories.Lease{BindingID: bindingID, PeerID: peerID, Token: 1, ExpiresAt: time.Now().Add(ttl)}, nil
+		},
+	).AnyTimes()
+	leases.EXPECT().RenewLease(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, lease repositories.Lease, ttl time.Duration) (*repositories.Lease, error) {
+			lease.ExpiresAt = time.Now().Add(ttl)
+			return &lease, nil
+		},
+	).AnyTimes()
+	leases.EXPECT().ReleaseLease(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return leases
 }
@@ -3,11 +3,13 @@ package daemons
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
+	"github.com/JoeReid/jetbridge/health"
+	"github.com/JoeReid/jetbridge/metrics"
 	"github.com/JoeReid/jetbridge/repositories"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -35,10 +37,18 @@ func (p *PeerMembership) Wait() error {
 	}
 }
 
-func NewPeerMembership(parent context.Context, peers repositories.Peers) (*PeerMembership, context.Context) {
+// NewPeerMembership joins the cluster as described by join, maintaining
+// membership until the returned context is done. The state of the heartbeat is reported to checker
+// as a liveness component, which fails if a heartbeat is missed.
+func NewPeerMembership(parent context.Context, peers repositories.Peers, join *repositories.JoinPeer, checker *health.Checker, logger *zap.Logger) (*PeerMembership, context.Context) {
+	logger = logger.With(zap.String("component", "peer_membership"))
+
 	// Join the cluster
-	peer, err := peers.JoinPeers(parent)
+	peer, err := peers.JoinPeers(parent, join)
 	if err != nil {
+		logger.Error("failed to join cluster", zap.Error(err))
+		checker.Report("peer_membership", err, health.WithSeverity(health.Liveness))
+
 		ctx, cancel := context.WithCancel(parent)
 		eg, ctx := errgroup.WithContext(ctx)
 		cancel()
@@ -50,6 +60,12 @@ func NewPeerMembership(parent context.Context, peers repositories.Peers) (*PeerM
 		}, ctx
 	}
 
+	logger = logger.With(zap.String("peer_id", peer.ID.String()))
+	logger.Info("joined cluster")
+
+	metrics.PeerMembershipChanges.WithLabelValues("join").Inc()
+	checker.Report("peer_membership", nil, health.WithSeverity(health.Liveness), health.WithTTL(time.Until(peer.HeartbeatDueBy)))
+
 	// Create a new context and errgroup managing child goroutines
 	eg, ctx := errgroup.WithContext(parent)
 
@@ -65,11 +81,18 @@ func NewPeerMembership(parent context.Context, peers repositories.Peers) (*PeerM
 				return ctx.Err()
 
 			case <-time.After(time.Until(dueBy) / 2):
+				start := time.Now()
 				updatedPeer, err := peers.SendHeartbeat(ctx, peer.ID)
+				metrics.HeartbeatDuration.Observe(time.Since(start).Seconds())
+
 				if err != nil {
+					logger.Error("failed to send heartbeat", zap.Error(err))
+					checker.Report("peer_membership", err, health.WithSeverity(health.Liveness))
 					return err
 				}
 				dueBy = updatedPeer.HeartbeatDueBy
+
+				checker.Report("peer_membership", nil, health.WithSeverity(health.Liveness), health.WithTTL(time.Until(dueBy)))
 			}
 		}
 	})
@@ -83,9 +106,14 @@ func NewPeerMembership(parent context.Context, peers repositories.Peers) (*PeerM
 
 		err := peers.LeavePeers(ctx, peer.ID)
 		if err != nil {
-			log.Printf("failed to leave cluster (peerID=%s): %v", peer.ID.String(), err)
+			logger.Error("failed to leave cluster", zap.Error(err))
+			return err
 		}
-		return err
+
+		logger.Info("left cluster")
+
+		metrics.PeerMembershipChanges.WithLabelValues("leave").Inc()
+		return nil
 	})
 
 	return &PeerMembership{
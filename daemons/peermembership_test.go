@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 )
 
 func TestPeerMembership_join(t *testing.T) {
@@ -21,7 +22,7 @@ func TestPeerMembership_join(t *testing.T) {
 	id := uuid.New()
 
 	peers := mocks.NewMockPeers(ctrl)
-	peers.EXPECT().JoinPeers(gomock.Any()).Return(&repositories.Peer{
+	peers.EXPECT().JoinPeers(gomock.Any(), gomock.Any()).Return(&repositories.Peer{
 		ID:             id,
 		Hostname:       "test",
 		JoinedAt:       time.Now(),
@@ -33,7 +34,7 @@ func TestPeerMembership_join(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	candidate, _ := NewPeerMembership(ctx, peers)
+	candidate, _ := NewPeerMembership(ctx, peers, &repositories.JoinPeer{Weight: 1}, nil, zap.NewNop())
 
 	assert.ErrorContains(t, candidate.Wait(), "context deadline exceeded")
 }
@@ -45,12 +46,12 @@ func TestPeerMembership_joinFailure(t *testing.T) {
 	defer ctrl.Finish()
 
 	peers := mocks.NewMockPeers(ctrl)
-	peers.EXPECT().JoinPeers(gomock.Any()).Return(nil, assert.AnError)
+	peers.EXPECT().JoinPeers(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	candidate, _ := NewPeerMembership(ctx, peers)
+	candidate, _ := NewPeerMembership(ctx, peers, &repositories.JoinPeer{Weight: 1}, nil, zap.NewNop())
 
 	assert.ErrorIs(t, candidate.Wait(), assert.AnError)
 }
@@ -64,7 +65,7 @@ func TestPeerMembership_heartbeat(t *testing.T) {
 	id := uuid.New()
 
 	peers := mocks.NewMockPeers(ctrl)
-	peers.EXPECT().JoinPeers(gomock.Any()).Return(&repositories.Peer{
+	peers.EXPECT().JoinPeers(gomock.Any(), gomock.Any()).Return(&repositories.Peer{
 		ID:             id,
 		Hostname:       "test",
 		JoinedAt:       time.Now(),
@@ -90,7 +91,7 @@ func TestPeerMembership_heartbeat(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	candidate, _ := NewPeerMembership(ctx, peers)
+	candidate, _ := NewPeerMembership(ctx, peers, &repositories.JoinPeer{Weight: 1}, nil, zap.NewNop())
 
 	assert.ErrorContains(t, candidate.Wait(), "context deadline exceeded")
 }
@@ -104,7 +105,7 @@ func TestPeerMembership_heartbeatFailure(t *testing.T) {
 	id := uuid.New()
 
 	peers := mocks.NewMockPeers(ctrl)
-	peers.EXPECT().JoinPeers(gomock.Any()).Return(&repositories.Peer{
+	peers.EXPECT().JoinPeers(gomock.Any(), gomock.Any()).Return(&repositories.Peer{
 		ID:             id,
 		Hostname:       "test",
 		JoinedAt:       time.Now(),
@@ -117,7 +118,7 @@ func TestPeerMembership_heartbeatFailure(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	candidate, _ := NewPeerMembership(ctx, peers)
+	candidate, _ := NewPeerMembership(ctx, peers, &repositories.JoinPeer{Weight: 1}, nil, zap.NewNop())
 
 	assert.ErrorIs(t, candidate.Wait(), assert.AnError)
 }
@@ -131,7 +132,7 @@ func TestPeerMembership_workers(t *testing.T) {
 	id := uuid.New()
 
 	peers := mocks.NewMockPeers(ctrl)
-	peers.EXPECT().JoinPeers(gomock.Any()).Return(&repositories.Peer{
+	peers.EXPECT().JoinPeers(gomock.Any(), gomock.Any()).Return(&repositories.Peer{
 		ID:             id,
 		Hostname:       "test",
 		JoinedAt:       time.Now(),
@@ -143,7 +144,7 @@ func TestPeerMembership_workers(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	candidate, ctx := NewPeerMembership(ctx, peers)
+	candidate, ctx := NewPeerMembership(ctx, peers, &repositories.JoinPeer{Weight: 1}, nil, zap.NewNop())
 
 	var (
 		called int
@@ -180,7 +181,7 @@ func TestPeerMembership_workerFailure(t *testing.T) {
 	id := uuid.New()
 
 	peers := mocks.NewMockPeers(ctrl)
-	peers.EXPECT().JoinPeers(gomock.Any()).Return(&repositories.Peer{
+	peers.EXPECT().JoinPeers(gomock.Any(), gomock.Any()).Return(&repositories.Peer{
 		ID:             id,
 		Hostname:       "test",
 		JoinedAt:       time.Now(),
@@ -192,7 +193,7 @@ func TestPeerMembership_workerFailure(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
 	defer cancel()
 
-	candidate, ctx := NewPeerMembership(ctx, peers)
+	candidate, ctx := NewPeerMembership(ctx, peers, &repositories.JoinPeer{Weight: 1}, nil, zap.NewNop())
 
 	var (
 		called int
@@ -0,0 +1,19 @@
+package jetbridge
+
+// Headers added to messages published to a binding's dead-letter subject,
+// alongside the original headers of the message other than the Nats-* headers
+// that control how it was published.
+const (
+	// DeadLetterBindingIDHeader is the ID of the binding that gave up on the message.
+	DeadLetterBindingIDHeader = "Jetbridge-Binding-Id"
+	// DeadLetterErrorHeader is the error returned by the last delivery to the target.
+	DeadLetterErrorHeader = "Jetbridge-Last-Error"
+	// DeadLetterNumDeliveredHeader is the number of times the message was delivered.
+	DeadLetterNumDeliveredHeader = "Jetbridge-Num-Delivered"
+	// DeadLetterStreamHeader is the stream the message was originally read from.
+	DeadLetterStreamHeader = "Jetbridge-Stream"
+	// DeadLetterStreamSequenceHeader is the original stream sequence of the message.
+	DeadLetterStreamSequenceHeader = "Jetbridge-Stream-Sequence"
+	// DeadLetterSubjectHeader is the subject the message was originally published to.
+	DeadLetterSubjectHeader = "Jetbridge-Subject"
+)
@@ -0,0 +1,231 @@
+// Package health aggregates the health of the components of the jetbridge
+// server, for gRPC health checks and HTTP liveness and readiness probes.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+)
+
+var _ grpchealth.Checker = (*Checker)(nil)
+
+// Severity controls which checks an unhealthy component fails.
+type Severity int
+
+const (
+	// Informational components are reported but never fail a check.
+	Informational Severity = iota
+	// Readiness components fail readiness checks, so the server is taken
+	// out of service until they recover.
+	Readiness
+	// Liveness components fail both liveness and readiness checks, so the
+	// server is restarted if they do not recover.
+	Liveness
+)
+
+// Option configures a component of the Checker.
+type Option func(*component)
+
+// WithSeverity sets the severity of a component. The default is Readiness.
+func WithSeverity(severity Severity) Option {
+	return func(c *component) {
+		c.severity = severity
+	}
+}
+
+// WithTTL marks a reported component unhealthy if it is not reported again
+// within ttl, so that a component that has stopped reporting is not assumed
+// to be healthy.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *component) {
+		c.ttl = ttl
+	}
+}
+
+type component struct {
+	severity Severity
+	ttl      time.Duration
+
+	probe func(context.Context) error
+
+	err        error
+	reportedAt time.Time
+}
+
+func (c *component) check(ctx context.Context, now time.Time) error {
+	if c.probe != nil {
+		return c.probe(ctx)
+	}
+
+	if c.ttl > 0 && now.Sub(c.reportedAt) > c.ttl {
+		return fmt.Errorf("not reported since %s", c.reportedAt.Format(time.RFC3339))
+	}
+
+	return c.err
+}
+
+// Checker tracks the health of named components, either reported as they
+// change or probed on demand. A nil Checker ignores reports and is always
+// healthy, so components can be run without health checking.
+type Checker struct {
+	mu         sync.RWMutex
+	now        func() time.Time
+	services   []string
+	components map[string]*component
+}
+
+// NewChecker returns a Checker reporting on the given fully-qualified
+// protobuf service names, along with the whole process.
+func NewChecker(services ...string) *Checker {
+	return &Checker{
+		now:        time.Now,
+		services:   services,
+		components: make(map[string]*component),
+	}
+}
+
+// AddProbe registers a component whose health is checked by calling probe.
+func (c *Checker) AddProbe(name string, probe func(context.Context) error, opts ...Option) {
+	if c == nil {
+		return
+	}
+
+	comp := &component{severity: Readiness, probe: probe}
+	for _, opt := range opts {
+		opt(comp)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.components[name] = comp
+}
+
+// Report records the health of a component, registering it if necessary. A
+// nil err reports the component as healthy.
+func (c *Checker) Report(name string, err error, opts ...Option) {
+	if c == nil {
+		return
+	}
+
+	comp := &component{severity: Readiness}
+	for _, opt := range opts {
+		opt(comp)
+	}
+	comp.err = err
+	comp.reportedAt = c.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.components[name] = comp
+}
+
+// Remove stops tracking a component, such as a worker that has been stopped.
+func (c *Checker) Remove(name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.components, name)
+}
+
+// Result is the outcome of checking every component.
+type Result struct {
+	Healthy    bool              `json:"healthy"`
+	Components map[string]string `json:"components"`
+}
+
+// Evaluate checks every component, reporting the checker as unhealthy if any
+// component of at least the given severity is unhealthy.
+func (c *Checker) Evaluate(ctx context.Context, severity Severity) Result {
+	result := Result{Healthy: true, Components: make(map[string]string)}
+	if c == nil {
+		return result
+	}
+
+	c.mu.RLock()
+	components := make(map[string]*component, len(c.components))
+	for name, comp := range c.components {
+		components[name] = comp
+	}
+	c.mu.RUnlock()
+
+	now := c.now()
+	for name, comp := range components {
+		err := comp.check(ctx, now)
+		if err == nil {
+			result.Components[name] = "ok"
+			continue
+		}
+
+		result.Components[name] = err.Error()
+		if comp.severity >= severity {
+			result.Healthy = false
+		}
+	}
+
+	return result
+}
+
+// Check implements grpchealth.Checker, reporting the readiness of the server
+// for the whole process and for each of its services.
+func (c *Checker) Check(ctx context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	if req.Service != "" && !c.hasService(req.Service) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown service %s", req.Service))
+	}
+
+	if !c.Evaluate(ctx, Readiness).Healthy {
+		return &grpchealth.CheckResponse{Status: grpchealth.StatusNotServing}, nil
+	}
+
+	return &grpchealth.CheckResponse{Status: grpchealth.StatusServing}, nil
+}
+
+func (c *Checker) hasService(service string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, s := range c.services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+// LivenessHandler serves a liveness probe, responding 503 if any Liveness
+// component is unhealthy.
+func (c *Checker) LivenessHandler() http.Handler {
+	return c.handler(Liveness)
+}
+
+// ReadinessHandler serves a readiness probe, responding 503 if any Readiness
+// or Liveness component is unhealthy.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return c.handler(Readiness)
+}
+
+func (c *Checker) handler(severity Severity) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := c.Evaluate(r.Context(), severity)
+
+		w.Header().Set("Content-Type", "application/json")
+		if !result.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
@@ -0,0 +1,144 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Evaluate(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setup     func(c *Checker)
+		liveness  bool
+		readiness bool
+	}{
+		{
+			name:      "no components",
+			setup:     func(c *Checker) {},
+			liveness:  true,
+			readiness: true,
+		},
+		{
+			name: "healthy",
+			setup: func(c *Checker) {
+				c.Report("bindings", nil)
+				c.AddProbe("nats", func(context.Context) error { return nil })
+			},
+			liveness:  true,
+			readiness: true,
+		},
+		{
+			name: "readiness failure",
+			setup: func(c *Checker) {
+				c.AddProbe("nats", func(context.Context) error { return assert.AnError })
+			},
+			liveness:  true,
+			readiness: false,
+		},
+		{
+			name: "liveness failure",
+			setup: func(c *Checker) {
+				c.Report("peer_membership", assert.AnError, WithSeverity(Liveness))
+			},
+			liveness:  false,
+			readiness: false,
+		},
+		{
+			name: "informational failure",
+			setup: func(c *Checker) {
+				c.Report("binding/1", assert.AnError, WithSeverity(Informational))
+			},
+			liveness:  true,
+			readiness: true,
+		},
+		{
+			name: "stale report",
+			setup: func(c *Checker) {
+				c.Report("bindings", nil, WithTTL(time.Second))
+				c.now = func() time.Time { return now.Add(2 * time.Second) }
+			},
+			liveness:  true,
+			readiness: false,
+		},
+		{
+			name: "fresh report",
+			setup: func(c *Checker) {
+				c.Report("bindings", nil, WithTTL(time.Second))
+				c.now = func() time.Time { return now.Add(500 * time.Millisecond) }
+			},
+			liveness:  true,
+			readiness: true,
+		},
+		{
+			name: "removed",
+			setup: func(c *Checker) {
+				c.Report("bindings", assert.AnError)
+				c.Remove("bindings")
+			},
+			liveness:  true,
+			readiness: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidate := NewChecker()
+			candidate.now = func() time.Time { return now }
+			tt.setup(candidate)
+
+			assert.Equal(t, tt.liveness, candidate.Evaluate(context.TODO(), Liveness).Healthy)
+			assert.Equal(t, tt.readiness, candidate.Evaluate(context.TODO(), Readiness).Healthy)
+		})
+	}
+}
+
+func TestChecker_nil(t *testing.T) {
+	var candidate *Checker
+
+	candidate.Report("bindings", assert.AnError)
+	candidate.AddProbe("nats", func(context.Context) error { return assert.AnError })
+	candidate.Remove("bindings")
+
+	assert.True(t, candidate.Evaluate(context.TODO(), Readiness).Healthy)
+}
+
+func TestChecker_Check(t *testing.T) {
+	candidate := NewChecker("test.v1.TestService")
+
+	resp, err := candidate.Check(context.TODO(), &grpchealth.CheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpchealth.StatusServing, resp.Status)
+
+	candidate.Report("bindings", assert.AnError)
+
+	resp, err = candidate.Check(context.TODO(), &grpchealth.CheckRequest{Service: "test.v1.TestService"})
+	require.NoError(t, err)
+	assert.Equal(t, grpchealth.StatusNotServing, resp.Status)
+
+	_, err = candidate.Check(context.TODO(), &grpchealth.CheckRequest{Service: "test.v1.UnknownService"})
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestChecker_handlers(t *testing.T) {
+	candidate := NewChecker()
+	candidate.Report("bindings", assert.AnError)
+
+	rec := httptest.NewRecorder()
+	candidate.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	candidate.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"healthy":false,"components":{"bindings":"`+assert.AnError.Error()+`"}}`, rec.Body.String())
+}
@@ -0,0 +1,102 @@
+package jetbridge
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Content types of the payloads sent to webhook and NATS targets.
+const (
+	ContentTypeJSON            = "application/json"
+	ContentTypeCloudEvent      = "application/cloudevents+json"
+	ContentTypeCloudEventBatch = "application/cloudevents-batch+json"
+	ContentTypeOctetStream     = "application/octet-stream"
+)
+
+// JSONMessage is a message in the json payload format, a stable snake_case
+// schema that does not depend on how Go encodes the nats package's types.
+//
+// For example:
+//
+//	{
+//	  "subject": "orders.created",
+//	  "headers": {"Content-Type": ["application/json"]},
+//	  "data": "eyJpZCI6NDJ9",
+//	  "stream": "ORDERS",
+//	  "consumer": "2b0c3cde-5dd8-4d0c-9d5c-43d1f1a4c9b6",
+//	  "stream_sequence": 1042,
+//	  "consumer_sequence": 17,
+//	  "num_delivered": 1,
+//	  "num_pending": 3,
+//	  "timestamp": "2023-04-01T12:00:00.123456789Z"
+//	}
+type JSONMessage struct {
+	Subject string `json:"subject"`
+	// Headers is omitted if the message has no headers.
+	Headers nats.Header `json:"headers,omitempty"`
+	// Data is base64 encoded, and an empty string if the message has no data.
+	Data     []byte `json:"data"`
+	Stream   string `json:"stream"`
+	Consumer string `json:"consumer"`
+	// StreamSequence identifies the message in a JetstreamBatchItemFailure.
+	StreamSequence   uint64 `json:"stream_sequence"`
+	ConsumerSequence uint64 `json:"consumer_sequence"`
+	// NumDelivered is 1 on the first delivery of the message, and counts each
+	// redelivery after that.
+	NumDelivered uint64 `json:"num_delivered"`
+	NumPending   uint64 `json:"num_pending"`
+	// Timestamp is when the message was stored in the stream.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// JSONMessageBatch is a batch of messages in the json payload format.
+type JSONMessageBatch struct {
+	Messages []JSONMessage `json:"messages"`
+}
+
+// NewJSONMessage converts a message to the json payload format.
+func NewJSONMessage(payload JetstreamLambdaPayload) JSONMessage {
+	data := payload.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	var headers nats.Header
+	if len(payload.Header) > 0 {
+		headers = payload.Header
+	}
+
+	return JSONMessage{
+		Subject:          payload.Subject,
+		Headers:          headers,
+		Data:             data,
+		Stream:           payload.Metadata.Stream,
+		Consumer:         payload.Metadata.Consumer,
+		StreamSequence:   payload.Metadata.Sequence.Stream,
+		ConsumerSequence: payload.Metadata.Sequence.Consumer,
+		NumDelivered:     payload.Metadata.NumDelivered,
+		NumPending:       payload.Metadata.NumPending,
+		Timestamp:        payload.Metadata.Timestamp,
+	}
+}
+
+// NewJSONMessageBatch converts a batch of messages to the json payload format.
+func NewJSONMessageBatch(batch JetstreamBatchedLambdaPayload) JSONMessageBatch {
+	messages := make([]JSONMessage, 0, len(batch))
+	for _, payload := range batch {
+		messages = append(messages, NewJSONMessage(payload))
+	}
+
+	return JSONMessageBatch{Messages: messages}
+}
+
+// RawContentType returns the content type of a message sent in the raw payload
+// format, which is that given by its Content-Type header, if it has one.
+func RawContentType(payload JetstreamLambdaPayload) string {
+	if contentType := payload.Header.Get("Content-Type"); contentType != "" {
+		return contentType
+	}
+
+	return ContentTypeOctetStream
+}
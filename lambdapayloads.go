@@ -12,3 +12,26 @@ type JetstreamLambdaPayload struct {
 	Data     []byte
 	Metadata nats.MsgMetadata
 }
+
+// JetstreamBatchedLambdaResponse may be returned by a Lambda handling a
+// JetstreamBatchedLambdaPayload to report which messages of the batch it
+// failed to process. Messages that are not listed are ACK'd.
+//
+// The batch is treated as a complete success if the function returns nothing,
+// null, or an empty list of failures. It is treated as a complete failure, and
+// every message is NAK'd, if the response is not valid JSON or a failure
+// references a sequence number that is not part of the batch.
+type JetstreamBatchedLambdaResponse struct {
+	BatchItemFailures []JetstreamBatchItemFailure `json:"batchItemFailures"`
+}
+
+// JetstreamBatchItemFailure identifies a single failed message in a batch by
+// its stream sequence number (Metadata.Sequence.Stream in the payload).
+//
+// Failed messages are NAK'd so they are redelivered, unless Terminate is set,
+// in which case they are terminated and never redelivered, having first been
+// published to the binding's dead-letter subject if it has one.
+type JetstreamBatchItemFailure struct {
+	Sequence  uint64 `json:"sequence"`
+	Terminate bool   `json:"terminate,omitempty"`
+}
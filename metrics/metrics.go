@@ -0,0 +1,115 @@
+// Package metrics defines the Prometheus metrics exported by the jetbridge
+// server, and the handler serving them.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "jetbridge"
+
+var (
+	MessagesFetched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_fetched_total",
+		Help:      "The number of messages fetched from JetStream.",
+	}, []string{"binding_id"})
+
+	MessagesAcked = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_acked_total",
+		Help:      "The number of messages ACK'd after being handled successfully.",
+	}, []string{"binding_id"})
+
+	MessagesNakked = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_nakked_total",
+		Help:      "The number of messages NAK'd for redelivery.",
+	}, []string{"binding_id"})
+
+	MessagesTerminated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_terminated_total",
+		Help:      "The number of messages terminated without further redelivery.",
+	}, []string{"binding_id"})
+
+	MessagesDeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_dead_lettered_total",
+		Help:      "The number of messages published to a dead-letter subject.",
+	}, []string{"binding_id"})
+
+	ConsumerPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "consumer_pending_messages",
+		Help:      "The number of messages pending on a binding's consumer, as of the last fetch.",
+	}, []string{"binding_id"})
+
+	BatchSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "batch_size_messages",
+		Help:      "The number of messages in each batch dispatched to a handler.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 11),
+	}, []string{"binding_id"})
+
+	LambdaInvocationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "lambda_invocation_duration_seconds",
+		Help:      "The time taken to invoke a Lambda function.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"function_name"})
+
+	LambdaInvocationErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "lambda_invocation_errors_total",
+		Help:      "The number of Lambda invocations that failed or returned a function error.",
+	}, []string{"function_name"})
+
+	WebhookRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "webhook_request_duration_seconds",
+		Help:      "The time taken to POST messages to a webhook.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"binding_id"})
+
+	WebhookRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_request_errors_total",
+		Help:      "The number of webhook requests that failed or returned a non-2xx status.",
+	}, []string{"binding_id"})
+
+	NATSRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "nats_request_duration_seconds",
+		Help:      "The time taken for a NATS target to reply to a request.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"binding_id"})
+
+	NATSRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "nats_request_errors_total",
+		Help:      "The number of NATS target requests that failed or were replied to with a service error.",
+	}, []string{"binding_id"})
+
+	HeartbeatDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "peer_heartbeat_duration_seconds",
+		Help:      "The time taken to send a peer heartbeat.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	PeerMembershipChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "peer_membership_changes_total",
+		Help:      "The number of times this server has joined or left the cluster.",
+	}, []string{"change"})
+)
+
+// Handler serves the registered metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
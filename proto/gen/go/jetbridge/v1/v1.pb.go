@@ -23,6 +23,132 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// InvocationType selects how the Lambda is invoked, and so when messages are ACK'd.
+type InvocationType int32
+
+const (
+	// Defaults to INVOCATION_TYPE_REQUEST_RESPONSE.
+	InvocationType_INVOCATION_TYPE_UNSPECIFIED InvocationType = 0
+	// Wait for the function to finish, ACK-ing messages only once it succeeds.
+	InvocationType_INVOCATION_TYPE_REQUEST_RESPONSE InvocationType = 1
+	// Queue the event with Lambda, ACK-ing messages as soon as Lambda accepts it.
+	// Failures are then handled by the function's own asynchronous retry and
+	// destination configuration.
+	InvocationType_INVOCATION_TYPE_EVENT InvocationType = 2
+	// Validate the invocation without running the function, ACK-ing messages
+	// once it is valid. Messages are consumed without being processed.
+	InvocationType_INVOCATION_TYPE_DRY_RUN InvocationType = 3
+)
+
+// Enum value maps for InvocationType.
+var (
+	InvocationType_name = map[int32]string{
+		0: "INVOCATION_TYPE_UNSPECIFIED",
+		1: "INVOCATION_TYPE_REQUEST_RESPONSE",
+		2: "INVOCATION_TYPE_EVENT",
+		3: "INVOCATION_TYPE_DRY_RUN",
+	}
+	InvocationType_value = map[string]int32{
+		"INVOCATION_TYPE_UNSPECIFIED":      0,
+		"INVOCATION_TYPE_REQUEST_RESPONSE": 1,
+		"INVOCATION_TYPE_EVENT":            2,
+		"INVOCATION_TYPE_DRY_RUN":          3,
+	}
+)
+
+func (x InvocationType) Enum() *InvocationType {
+	p := new(InvocationType)
+	*p = x
+	return p
+}
+
+func (x InvocationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvocationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jetbridge_v1_v1_proto_enumTypes[0].Descriptor()
+}
+
+func (InvocationType) Type() protoreflect.EnumType {
+	return &file_jetbridge_v1_v1_proto_enumTypes[0]
+}
+
+func (x InvocationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvocationType.Descriptor instead.
+func (InvocationType) EnumDescriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{0}
+}
+
+// PayloadFormat selects how messages are encoded in the requests made to a
+// binding's target. The jetbridge Go package has types for each format.
+type PayloadFormat int32
+
+const (
+	// Defaults to PAYLOAD_FORMAT_JETSTREAM.
+	PayloadFormat_PAYLOAD_FORMAT_UNSPECIFIED PayloadFormat = 0
+	// The JSON encoding of the Go jetbridge.JetstreamLambdaPayload, or a list of
+	// them for batched bindings, kept for compatibility with existing targets.
+	PayloadFormat_PAYLOAD_FORMAT_JETSTREAM PayloadFormat = 1
+	// A stable snake_case JSON object, or an object listing them under
+	// "messages" for batched bindings, with the data base64 encoded.
+	PayloadFormat_PAYLOAD_FORMAT_JSON PayloadFormat = 2
+	// A CloudEvents 1.0 event in the structured JSON format, or a JSON batch of
+	// events for batched bindings.
+	PayloadFormat_PAYLOAD_FORMAT_CLOUDEVENTS PayloadFormat = 3
+	// The message data, unchanged. Only unbatched bindings may use this format,
+	// and the data must be JSON for Lambda targets.
+	PayloadFormat_PAYLOAD_FORMAT_RAW PayloadFormat = 4
+)
+
+// Enum value maps for PayloadFormat.
+var (
+	PayloadFormat_name = map[int32]string{
+		0: "PAYLOAD_FORMAT_UNSPECIFIED",
+		1: "PAYLOAD_FORMAT_JETSTREAM",
+		2: "PAYLOAD_FORMAT_JSON",
+		3: "PAYLOAD_FORMAT_CLOUDEVENTS",
+		4: "PAYLOAD_FORMAT_RAW",
+	}
+	PayloadFormat_value = map[string]int32{
+		"PAYLOAD_FORMAT_UNSPECIFIED": 0,
+		"PAYLOAD_FORMAT_JETSTREAM":   1,
+		"PAYLOAD_FORMAT_JSON":        2,
+		"PAYLOAD_FORMAT_CLOUDEVENTS": 3,
+		"PAYLOAD_FORMAT_RAW":         4,
+	}
+)
+
+func (x PayloadFormat) Enum() *PayloadFormat {
+	p := new(PayloadFormat)
+	*p = x
+	return p
+}
+
+func (x PayloadFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayloadFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_jetbridge_v1_v1_proto_enumTypes[1].Descriptor()
+}
+
+func (PayloadFormat) Type() protoreflect.EnumType {
+	return &file_jetbridge_v1_v1_proto_enumTypes[1]
+}
+
+func (x PayloadFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayloadFormat.Descriptor instead.
+func (PayloadFormat) EnumDescriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{1}
+}
+
 type ListPeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -113,6 +239,10 @@ type CreateBindingRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Deprecated: use target. Gives a Lambda target, and may only be set if
+	// target is not.
+	//
+	// Deprecated: Do not use.
 	LambdaArn       string               `protobuf:"bytes,1,opt,name=lambda_arn,json=lambdaArn,proto3" json:"lambda_arn,omitempty"`
 	Stream          string               `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
 	SubjectPattern  string               `protobuf:"bytes,3,opt,name=subject_pattern,json=subjectPattern,proto3" json:"subject_pattern,omitempty"`
@@ -122,7 +252,19 @@ type CreateBindingRequest struct {
 	//	*CreateBindingRequest_Policy
 	//	*CreateBindingRequest_StartTime
 	//	*CreateBindingRequest_StartSequence
-	DeliveryPolicy isCreateBindingRequest_DeliveryPolicy `protobuf_oneof:"delivery_policy"`
+	DeliveryPolicy    isCreateBindingRequest_DeliveryPolicy `protobuf_oneof:"delivery_policy"`
+	MaxDeliveries     int64                                 `protobuf:"varint,9,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"`
+	DeadLetterSubject string                                `protobuf:"bytes,10,opt,name=dead_letter_subject,json=deadLetterSubject,proto3" json:"dead_letter_subject,omitempty"`
+	RetryPolicy       *RetryPolicy                          `protobuf:"bytes,11,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	InvocationType    InvocationType                        `protobuf:"varint,12,opt,name=invocation_type,json=invocationType,proto3,enum=jetbridge.v1.InvocationType" json:"invocation_type,omitempty"`
+	MaxConcurrency    int64                                 `protobuf:"varint,13,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	PartitionKey      *PartitionKey                         `protobuf:"bytes,14,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	// Restricts the binding to peers having all of the labels.
+	RequiredLabels map[string]string `protobuf:"bytes,15,rep,name=required_labels,json=requiredLabels,proto3" json:"required_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Required, unless the deprecated lambda_arn is set.
+	Target *Target `protobuf:"bytes,16,opt,name=target,proto3" json:"target,omitempty"`
+	// PAYLOAD_FORMAT_RAW may only be used by unbatched bindings.
+	PayloadFormat PayloadFormat `protobuf:"varint,17,opt,name=payload_format,json=payloadFormat,proto3,enum=jetbridge.v1.PayloadFormat" json:"payload_format,omitempty"`
 }
 
 func (x *CreateBindingRequest) Reset() {
@@ -157,6 +299,7 @@ func (*CreateBindingRequest) Descriptor() ([]byte, []int) {
 	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{2}
 }
 
+// Deprecated: Do not use.
 func (x *CreateBindingRequest) GetLambdaArn() string {
 	if x != nil {
 		return x.LambdaArn
@@ -220,6 +363,69 @@ func (x *CreateBindingRequest) GetStartSequence() uint64 {
 	return 0
 }
 
+func (x *CreateBindingRequest) GetMaxDeliveries() int64 {
+	if x != nil {
+		return x.MaxDeliveries
+	}
+	return 0
+}
+
+func (x *CreateBindingRequest) GetDeadLetterSubject() string {
+	if x != nil {
+		return x.DeadLetterSubject
+	}
+	return ""
+}
+
+func (x *CreateBindingRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateBindingRequest) GetInvocationType() InvocationType {
+	if x != nil {
+		return x.InvocationType
+	}
+	return InvocationType_INVOCATION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateBindingRequest) GetMaxConcurrency() int64 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *CreateBindingRequest) GetPartitionKey() *PartitionKey {
+	if x != nil {
+		return x.PartitionKey
+	}
+	return nil
+}
+
+func (x *CreateBindingRequest) GetRequiredLabels() map[string]string {
+	if x != nil {
+		return x.RequiredLabels
+	}
+	return nil
+}
+
+func (x *CreateBindingRequest) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CreateBindingRequest) GetPayloadFormat() PayloadFormat {
+	if x != nil {
+		return x.PayloadFormat
+	}
+	return PayloadFormat_PAYLOAD_FORMAT_UNSPECIFIED
+}
+
 type isCreateBindingRequest_DeliveryPolicy interface {
 	isCreateBindingRequest_DeliveryPolicy()
 }
@@ -468,16 +674,33 @@ func (x *ListBindingsResponse) GetBindings() []*JetstreamBinding {
 	return nil
 }
 
-type DeleteBindingRequest struct {
+// Fields left unset keep their current value. The updated binding must satisfy
+// the same constraints as a CreateBindingRequest.
+type UpdateBindingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *DeleteBindingRequest) Reset() {
-	*x = DeleteBindingRequest{}
+	Id                string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectPattern    *string              `protobuf:"bytes,3,opt,name=subject_pattern,json=subjectPattern,proto3,oneof" json:"subject_pattern,omitempty"`
+	MaxBatchSize      *int64               `protobuf:"varint,4,opt,name=max_batch_size,json=maxBatchSize,proto3,oneof" json:"max_batch_size,omitempty"`
+	MaxBatchLatency   *durationpb.Duration `protobuf:"bytes,5,opt,name=max_batch_latency,json=maxBatchLatency,proto3" json:"max_batch_latency,omitempty"`
+	MaxDeliveries     *int64               `protobuf:"varint,6,opt,name=max_deliveries,json=maxDeliveries,proto3,oneof" json:"max_deliveries,omitempty"`
+	DeadLetterSubject *string              `protobuf:"bytes,7,opt,name=dead_letter_subject,json=deadLetterSubject,proto3,oneof" json:"dead_letter_subject,omitempty"`
+	RetryPolicy       *RetryPolicy         `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	InvocationType    *InvocationType      `protobuf:"varint,9,opt,name=invocation_type,json=invocationType,proto3,enum=jetbridge.v1.InvocationType,oneof" json:"invocation_type,omitempty"`
+	MaxConcurrency    *int64               `protobuf:"varint,10,opt,name=max_concurrency,json=maxConcurrency,proto3,oneof" json:"max_concurrency,omitempty"`
+	// An empty partition key disables partitioning. Partitioned bindings must
+	// remain batched.
+	PartitionKey *PartitionKey `protobuf:"bytes,11,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	// An empty set of labels lets any peer run the binding.
+	RequiredLabels *Labels        `protobuf:"bytes,12,opt,name=required_labels,json=requiredLabels,proto3" json:"required_labels,omitempty"`
+	Target         *Target        `protobuf:"bytes,13,opt,name=target,proto3" json:"target,omitempty"`
+	PayloadFormat  *PayloadFormat `protobuf:"varint,14,opt,name=payload_format,json=payloadFormat,proto3,enum=jetbridge.v1.PayloadFormat,oneof" json:"payload_format,omitempty"`
+}
+
+func (x *UpdateBindingRequest) Reset() {
+	*x = UpdateBindingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jetbridge_v1_v1_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -485,13 +708,13 @@ func (x *DeleteBindingRequest) Reset() {
 	}
 }
 
-func (x *DeleteBindingRequest) String() string {
+func (x *UpdateBindingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBindingRequest) ProtoMessage() {}
+func (*UpdateBindingRequest) ProtoMessage() {}
 
-func (x *DeleteBindingRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateBindingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jetbridge_v1_v1_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,26 +726,112 @@ func (x *DeleteBindingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBindingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBindingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBindingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBindingRequest) Descriptor() ([]byte, []int) {
 	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteBindingRequest) GetId() string {
+func (x *UpdateBindingRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteBindingResponse struct {
+func (x *UpdateBindingRequest) GetSubjectPattern() string {
+	if x != nil && x.SubjectPattern != nil {
+		return *x.SubjectPattern
+	}
+	return ""
+}
+
+func (x *UpdateBindingRequest) GetMaxBatchSize() int64 {
+	if x != nil && x.MaxBatchSize != nil {
+		return *x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *UpdateBindingRequest) GetMaxBatchLatency() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBatchLatency
+	}
+	return nil
+}
+
+func (x *UpdateBindingRequest) GetMaxDeliveries() int64 {
+	if x != nil && x.MaxDeliveries != nil {
+		return *x.MaxDeliveries
+	}
+	return 0
+}
+
+func (x *UpdateBindingRequest) GetDeadLetterSubject() string {
+	if x != nil && x.DeadLetterSubject != nil {
+		return *x.DeadLetterSubject
+	}
+	return ""
+}
+
+func (x *UpdateBindingRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *UpdateBindingRequest) GetInvocationType() InvocationType {
+	if x != nil && x.InvocationType != nil {
+		return *x.InvocationType
+	}
+	return InvocationType_INVOCATION_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateBindingRequest) GetMaxConcurrency() int64 {
+	if x != nil && x.MaxConcurrency != nil {
+		return *x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *UpdateBindingRequest) GetPartitionKey() *PartitionKey {
+	if x != nil {
+		return x.PartitionKey
+	}
+	return nil
+}
+
+func (x *UpdateBindingRequest) GetRequiredLabels() *Labels {
+	if x != nil {
+		return x.RequiredLabels
+	}
+	return nil
+}
+
+func (x *UpdateBindingRequest) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *UpdateBindingRequest) GetPayloadFormat() PayloadFormat {
+	if x != nil && x.PayloadFormat != nil {
+		return *x.PayloadFormat
+	}
+	return PayloadFormat_PAYLOAD_FORMAT_UNSPECIFIED
+}
+
+type UpdateBindingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Binding *JetstreamBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
 }
 
-func (x *DeleteBindingResponse) Reset() {
-	*x = DeleteBindingResponse{}
+func (x *UpdateBindingResponse) Reset() {
+	*x = UpdateBindingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jetbridge_v1_v1_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -530,13 +839,13 @@ func (x *DeleteBindingResponse) Reset() {
 	}
 }
 
-func (x *DeleteBindingResponse) String() string {
+func (x *UpdateBindingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBindingResponse) ProtoMessage() {}
+func (*UpdateBindingResponse) ProtoMessage() {}
 
-func (x *DeleteBindingResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateBindingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jetbridge_v1_v1_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,25 +857,28 @@ func (x *DeleteBindingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBindingResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBindingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBindingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBindingResponse) Descriptor() ([]byte, []int) {
 	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{9}
 }
 
-type Peer struct {
+func (x *UpdateBindingResponse) GetBinding() *JetstreamBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+type DeleteBindingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Hostname     string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Joined       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined,proto3" json:"joined,omitempty"`
-	LastSeen     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	HeartbeatDue *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=heartbeat_due,json=heartbeatDue,proto3" json:"heartbeat_due,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *Peer) Reset() {
-	*x = Peer{}
+func (x *DeleteBindingRequest) Reset() {
+	*x = DeleteBindingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_jetbridge_v1_v1_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,13 +886,13 @@ func (x *Peer) Reset() {
 	}
 }
 
-func (x *Peer) String() string {
+func (x *DeleteBindingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Peer) ProtoMessage() {}
+func (*DeleteBindingRequest) ProtoMessage() {}
 
-func (x *Peer) ProtoReflect() protoreflect.Message {
+func (x *DeleteBindingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jetbridge_v1_v1_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,83 +904,81 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
-func (*Peer) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBindingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBindingRequest) Descriptor() ([]byte, []int) {
 	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Peer) GetId() string {
+func (x *DeleteBindingRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Peer) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
+type DeleteBindingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Peer) GetJoined() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Joined
+func (x *DeleteBindingResponse) Reset() {
+	*x = DeleteBindingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Peer) GetLastSeen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastSeen
-	}
-	return nil
+func (x *DeleteBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Peer) GetHeartbeatDue() *timestamppb.Timestamp {
-	if x != nil {
-		return x.HeartbeatDue
+func (*DeleteBindingResponse) ProtoMessage() {}
+
+func (x *DeleteBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type JetstreamBinding struct {
+// Deprecated: Use DeleteBindingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBindingResponse) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{11}
+}
+
+type GetBindingStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	LambdaArn       string               `protobuf:"bytes,2,opt,name=lambda_arn,json=lambdaArn,proto3" json:"lambda_arn,omitempty"`
-	Stream          string               `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
-	ConsumerName    string               `protobuf:"bytes,4,opt,name=consumer_name,json=consumerName,proto3" json:"consumer_name,omitempty"`
-	SubjectPattern  string               `protobuf:"bytes,5,opt,name=subject_pattern,json=subjectPattern,proto3" json:"subject_pattern,omitempty"`
-	MaxBatchSize    int64                `protobuf:"varint,6,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
-	MaxBatchLatency *durationpb.Duration `protobuf:"bytes,7,opt,name=max_batch_latency,json=maxBatchLatency,proto3" json:"max_batch_latency,omitempty"`
-	// Types that are assignable to DeliveryPolicy:
-	//	*JetstreamBinding_Policy
-	//	*JetstreamBinding_StartTime
-	//	*JetstreamBinding_StartSequence
-	DeliveryPolicy isJetstreamBinding_DeliveryPolicy `protobuf_oneof:"delivery_policy"`
-	AssignedPeer   string                            `protobuf:"bytes,11,opt,name=assigned_peer,json=assignedPeer,proto3" json:"assigned_peer,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *JetstreamBinding) Reset() {
-	*x = JetstreamBinding{}
+func (x *GetBindingStatusRequest) Reset() {
+	*x = GetBindingStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_jetbridge_v1_v1_proto_msgTypes[11]
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JetstreamBinding) String() string {
+func (x *GetBindingStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JetstreamBinding) ProtoMessage() {}
+func (*GetBindingStatusRequest) ProtoMessage() {}
 
-func (x *JetstreamBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_jetbridge_v1_v1_proto_msgTypes[11]
+func (x *GetBindingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,116 +989,1277 @@ func (x *JetstreamBinding) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JetstreamBinding.ProtoReflect.Descriptor instead.
-func (*JetstreamBinding) Descriptor() ([]byte, []int) {
-	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetBindingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBindingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *JetstreamBinding) GetId() string {
+func (x *GetBindingStatusRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *JetstreamBinding) GetLambdaArn() string {
+type GetBindingStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *BindingStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetBindingStatusResponse) Reset() {
+	*x = GetBindingStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBindingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBindingStatusResponse) ProtoMessage() {}
+
+func (x *GetBindingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBindingStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBindingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBindingStatusResponse) GetStatus() *BindingStatus {
 	if x != nil {
-		return x.LambdaArn
+		return x.Status
+	}
+	return nil
+}
+
+type PauseBindingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PauseBindingRequest) Reset() {
+	*x = PauseBindingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseBindingRequest) ProtoMessage() {}
+
+func (x *PauseBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseBindingRequest.ProtoReflect.Descriptor instead.
+func (*PauseBindingRequest) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PauseBindingRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *JetstreamBinding) GetStream() string {
+type PauseBindingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Binding *JetstreamBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
+}
+
+func (x *PauseBindingResponse) Reset() {
+	*x = PauseBindingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseBindingResponse) ProtoMessage() {}
+
+func (x *PauseBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseBindingResponse.ProtoReflect.Descriptor instead.
+func (*PauseBindingResponse) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PauseBindingResponse) GetBinding() *JetstreamBinding {
 	if x != nil {
-		return x.Stream
+		return x.Binding
+	}
+	return nil
+}
+
+type ResumeBindingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ResumeBindingRequest) Reset() {
+	*x = ResumeBindingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeBindingRequest) ProtoMessage() {}
+
+func (x *ResumeBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeBindingRequest.ProtoReflect.Descriptor instead.
+func (*ResumeBindingRequest) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResumeBindingRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *JetstreamBinding) GetConsumerName() string {
+type ResumeBindingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Binding *JetstreamBinding `protobuf:"bytes,1,opt,name=binding,proto3" json:"binding,omitempty"`
+}
+
+func (x *ResumeBindingResponse) Reset() {
+	*x = ResumeBindingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeBindingResponse) ProtoMessage() {}
+
+func (x *ResumeBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeBindingResponse.ProtoReflect.Descriptor instead.
+func (*ResumeBindingResponse) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResumeBindingResponse) GetBinding() *JetstreamBinding {
 	if x != nil {
-		return x.ConsumerName
+		return x.Binding
+	}
+	return nil
+}
+
+type Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname     string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Joined       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined,proto3" json:"joined,omitempty"`
+	LastSeen     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	HeartbeatDue *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=heartbeat_due,json=heartbeatDue,proto3" json:"heartbeat_due,omitempty"`
+	// The capacity of the peer, which is assigned bindings in proportion to
+	// its weight relative to the other peers.
+	Weight float64           `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Labels map[string]string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Peer) Reset() {
+	*x = Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peer) ProtoMessage() {}
+
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Peer) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *JetstreamBinding) GetSubjectPattern() string {
+func (x *Peer) GetHostname() string {
 	if x != nil {
-		return x.SubjectPattern
+		return x.Hostname
 	}
 	return ""
 }
 
-func (x *JetstreamBinding) GetMaxBatchSize() int64 {
+func (x *Peer) GetJoined() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MaxBatchSize
+		return x.Joined
+	}
+	return nil
+}
+
+func (x *Peer) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *Peer) GetHeartbeatDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeartbeatDue
+	}
+	return nil
+}
+
+func (x *Peer) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Peer) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type JetstreamBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: use target. The function of a Lambda target, and empty for
+	// other targets.
+	//
+	// Deprecated: Do not use.
+	LambdaArn       string               `protobuf:"bytes,2,opt,name=lambda_arn,json=lambdaArn,proto3" json:"lambda_arn,omitempty"`
+	Stream          string               `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	ConsumerName    string               `protobuf:"bytes,4,opt,name=consumer_name,json=consumerName,proto3" json:"consumer_name,omitempty"`
+	SubjectPattern  string               `protobuf:"bytes,5,opt,name=subject_pattern,json=subjectPattern,proto3" json:"subject_pattern,omitempty"`
+	MaxBatchSize    int64                `protobuf:"varint,6,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	MaxBatchLatency *durationpb.Duration `protobuf:"bytes,7,opt,name=max_batch_latency,json=maxBatchLatency,proto3" json:"max_batch_latency,omitempty"`
+	// Types that are assignable to DeliveryPolicy:
+	//	*JetstreamBinding_Policy
+	//	*JetstreamBinding_StartTime
+	//	*JetstreamBinding_StartSequence
+	DeliveryPolicy    isJetstreamBinding_DeliveryPolicy `protobuf_oneof:"delivery_policy"`
+	AssignedPeer      string                            `protobuf:"bytes,11,opt,name=assigned_peer,json=assignedPeer,proto3" json:"assigned_peer,omitempty"`
+	MaxDeliveries     int64                             `protobuf:"varint,12,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"`
+	DeadLetterSubject string                            `protobuf:"bytes,13,opt,name=dead_letter_subject,json=deadLetterSubject,proto3" json:"dead_letter_subject,omitempty"`
+	RetryPolicy       *RetryPolicy                      `protobuf:"bytes,14,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	InvocationType    InvocationType                    `protobuf:"varint,15,opt,name=invocation_type,json=invocationType,proto3,enum=jetbridge.v1.InvocationType" json:"invocation_type,omitempty"`
+	MaxConcurrency    int64                             `protobuf:"varint,16,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	PartitionKey      *PartitionKey                     `protobuf:"bytes,17,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+	// Paused bindings are not run, but keep their consumer position.
+	Paused bool `protobuf:"varint,18,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Restricts the binding to peers having all of the labels.
+	RequiredLabels map[string]string `protobuf:"bytes,19,rep,name=required_labels,json=requiredLabels,proto3" json:"required_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Target         *Target           `protobuf:"bytes,20,opt,name=target,proto3" json:"target,omitempty"`
+	PayloadFormat  PayloadFormat     `protobuf:"varint,21,opt,name=payload_format,json=payloadFormat,proto3,enum=jetbridge.v1.PayloadFormat" json:"payload_format,omitempty"`
+}
+
+func (x *JetstreamBinding) Reset() {
+	*x = JetstreamBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JetstreamBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JetstreamBinding) ProtoMessage() {}
+
+func (x *JetstreamBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JetstreamBinding.ProtoReflect.Descriptor instead.
+func (*JetstreamBinding) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JetstreamBinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *JetstreamBinding) GetLambdaArn() string {
+	if x != nil {
+		return x.LambdaArn
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetConsumerName() string {
+	if x != nil {
+		return x.ConsumerName
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetSubjectPattern() string {
+	if x != nil {
+		return x.SubjectPattern
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetMaxBatchSize() int64 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *JetstreamBinding) GetMaxBatchLatency() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBatchLatency
+	}
+	return nil
+}
+
+func (m *JetstreamBinding) GetDeliveryPolicy() isJetstreamBinding_DeliveryPolicy {
+	if m != nil {
+		return m.DeliveryPolicy
+	}
+	return nil
+}
+
+func (x *JetstreamBinding) GetPolicy() string {
+	if x, ok := x.GetDeliveryPolicy().(*JetstreamBinding_Policy); ok {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetStartTime() *timestamppb.Timestamp {
+	if x, ok := x.GetDeliveryPolicy().(*JetstreamBinding_StartTime); ok {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *JetstreamBinding) GetStartSequence() uint64 {
+	if x, ok := x.GetDeliveryPolicy().(*JetstreamBinding_StartSequence); ok {
+		return x.StartSequence
+	}
+	return 0
+}
+
+func (x *JetstreamBinding) GetAssignedPeer() string {
+	if x != nil {
+		return x.AssignedPeer
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetMaxDeliveries() int64 {
+	if x != nil {
+		return x.MaxDeliveries
+	}
+	return 0
+}
+
+func (x *JetstreamBinding) GetDeadLetterSubject() string {
+	if x != nil {
+		return x.DeadLetterSubject
+	}
+	return ""
+}
+
+func (x *JetstreamBinding) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *JetstreamBinding) GetInvocationType() InvocationType {
+	if x != nil {
+		return x.InvocationType
+	}
+	return InvocationType_INVOCATION_TYPE_UNSPECIFIED
+}
+
+func (x *JetstreamBinding) GetMaxConcurrency() int64 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *JetstreamBinding) GetPartitionKey() *PartitionKey {
+	if x != nil {
+		return x.PartitionKey
+	}
+	return nil
+}
+
+func (x *JetstreamBinding) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *JetstreamBinding) GetRequiredLabels() map[string]string {
+	if x != nil {
+		return x.RequiredLabels
+	}
+	return nil
+}
+
+func (x *JetstreamBinding) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *JetstreamBinding) GetPayloadFormat() PayloadFormat {
+	if x != nil {
+		return x.PayloadFormat
+	}
+	return PayloadFormat_PAYLOAD_FORMAT_UNSPECIFIED
+}
+
+type isJetstreamBinding_DeliveryPolicy interface {
+	isJetstreamBinding_DeliveryPolicy()
+}
+
+type JetstreamBinding_Policy struct {
+	Policy string `protobuf:"bytes,8,opt,name=policy,proto3,oneof"`
+}
+
+type JetstreamBinding_StartTime struct {
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,oneof"`
+}
+
+type JetstreamBinding_StartSequence struct {
+	StartSequence uint64 `protobuf:"varint,10,opt,name=start_sequence,json=startSequence,proto3,oneof"`
+}
+
+func (*JetstreamBinding_Policy) isJetstreamBinding_DeliveryPolicy() {}
+
+func (*JetstreamBinding_StartTime) isJetstreamBinding_DeliveryPolicy() {}
+
+func (*JetstreamBinding_StartSequence) isJetstreamBinding_DeliveryPolicy() {}
+
+// BindingStatus is the runtime state of a binding, as seen by its consumer and
+// recorded by the peers that have run it.
+type BindingStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BindingId string `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	// Whether the assigned peer is currently running a worker for the binding.
+	Running bool `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	// Unset if the consumer has not yet been created.
+	Consumer         *ConsumerStatus        `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	LastSuccess      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorMessage string                 `protobuf:"bytes,6,opt,name=last_error_message,json=lastErrorMessage,proto3" json:"last_error_message,omitempty"`
+	// The peer that last recorded stats for the binding, and when.
+	ReportedBy string                 `protobuf:"bytes,7,opt,name=reported_by,json=reportedBy,proto3" json:"reported_by,omitempty"`
+	Reported   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reported,proto3" json:"reported,omitempty"`
+}
+
+func (x *BindingStatus) Reset() {
+	*x = BindingStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindingStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindingStatus) ProtoMessage() {}
+
+func (x *BindingStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindingStatus.ProtoReflect.Descriptor instead.
+func (*BindingStatus) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BindingStatus) GetBindingId() string {
+	if x != nil {
+		return x.BindingId
+	}
+	return ""
+}
+
+func (x *BindingStatus) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *BindingStatus) GetConsumer() *ConsumerStatus {
+	if x != nil {
+		return x.Consumer
+	}
+	return nil
+}
+
+func (x *BindingStatus) GetLastSuccess() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return nil
+}
+
+func (x *BindingStatus) GetLastError() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastError
+	}
+	return nil
+}
+
+func (x *BindingStatus) GetLastErrorMessage() string {
+	if x != nil {
+		return x.LastErrorMessage
+	}
+	return ""
+}
+
+func (x *BindingStatus) GetReportedBy() string {
+	if x != nil {
+		return x.ReportedBy
+	}
+	return ""
+}
+
+func (x *BindingStatus) GetReported() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Reported
+	}
+	return nil
+}
+
+type ConsumerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumPending                    uint64 `protobuf:"varint,1,opt,name=num_pending,json=numPending,proto3" json:"num_pending,omitempty"`
+	NumAckPending                 int64  `protobuf:"varint,2,opt,name=num_ack_pending,json=numAckPending,proto3" json:"num_ack_pending,omitempty"`
+	NumRedelivered                int64  `protobuf:"varint,3,opt,name=num_redelivered,json=numRedelivered,proto3" json:"num_redelivered,omitempty"`
+	LastDeliveredStreamSequence   uint64 `protobuf:"varint,4,opt,name=last_delivered_stream_sequence,json=lastDeliveredStreamSequence,proto3" json:"last_delivered_stream_sequence,omitempty"`
+	LastDeliveredConsumerSequence uint64 `protobuf:"varint,5,opt,name=last_delivered_consumer_sequence,json=lastDeliveredConsumerSequence,proto3" json:"last_delivered_consumer_sequence,omitempty"`
+}
+
+func (x *ConsumerStatus) Reset() {
+	*x = ConsumerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerStatus) ProtoMessage() {}
+
+func (x *ConsumerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerStatus.ProtoReflect.Descriptor instead.
+func (*ConsumerStatus) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConsumerStatus) GetNumPending() uint64 {
+	if x != nil {
+		return x.NumPending
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetNumAckPending() int64 {
+	if x != nil {
+		return x.NumAckPending
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetNumRedelivered() int64 {
+	if x != nil {
+		return x.NumRedelivered
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetLastDeliveredStreamSequence() uint64 {
+	if x != nil {
+		return x.LastDeliveredStreamSequence
+	}
+	return 0
+}
+
+func (x *ConsumerStatus) GetLastDeliveredConsumerSequence() uint64 {
+	if x != nil {
+		return x.LastDeliveredConsumerSequence
+	}
+	return 0
+}
+
+// Target identifies where a binding delivers its messages.
+type Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Target:
+	//	*Target_Lambda
+	//	*Target_Webhook
+	//	*Target_Nats
+	Target isTarget_Target `protobuf_oneof:"target"`
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *Target) GetTarget() isTarget_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *Target) GetLambda() *LambdaTarget {
+	if x, ok := x.GetTarget().(*Target_Lambda); ok {
+		return x.Lambda
+	}
+	return nil
+}
+
+func (x *Target) GetWebhook() *WebhookTarget {
+	if x, ok := x.GetTarget().(*Target_Webhook); ok {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *Target) GetNats() *NatsTarget {
+	if x, ok := x.GetTarget().(*Target_Nats); ok {
+		return x.Nats
+	}
+	return nil
+}
+
+type isTarget_Target interface {
+	isTarget_Target()
+}
+
+type Target_Lambda struct {
+	Lambda *LambdaTarget `protobuf:"bytes,3,opt,name=lambda,proto3,oneof"`
+}
+
+type Target_Webhook struct {
+	Webhook *WebhookTarget `protobuf:"bytes,4,opt,name=webhook,proto3,oneof"`
+}
+
+type Target_Nats struct {
+	Nats *NatsTarget `protobuf:"bytes,5,opt,name=nats,proto3,oneof"`
+}
+
+func (*Target_Lambda) isTarget_Target() {}
+
+func (*Target_Webhook) isTarget_Target() {}
+
+func (*Target_Nats) isTarget_Target() {}
+
+// LambdaTarget invokes a Lambda function.
+type LambdaTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name, or full or partial ARN, of the function. It may end with a
+	// version or alias, in which case qualifier must be unset. Servers run with
+	// --verify-lambda-targets also check that the function exists.
+	FunctionArn string `protobuf:"bytes,1,opt,name=function_arn,json=functionArn,proto3" json:"function_arn,omitempty"`
+	// The version or alias of the function to invoke, $LATEST if unset.
+	Qualifier string `protobuf:"bytes,2,opt,name=qualifier,proto3" json:"qualifier,omitempty"`
+}
+
+func (x *LambdaTarget) Reset() {
+	*x = LambdaTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LambdaTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LambdaTarget) ProtoMessage() {}
+
+func (x *LambdaTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LambdaTarget.ProtoReflect.Descriptor instead.
+func (*LambdaTarget) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LambdaTarget) GetFunctionArn() string {
+	if x != nil {
+		return x.FunctionArn
+	}
+	return ""
+}
+
+func (x *LambdaTarget) GetQualifier() string {
+	if x != nil {
+		return x.Qualifier
+	}
+	return ""
+}
+
+// WebhookTarget POSTs messages to an HTTPS endpoint. Unset options fall back
+// to the defaults the server is run with. Updating a binding's target replaces
+// all of its options, so the headers and signing secret must be given again.
+type WebhookTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Must be https, unless insecure is set.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Added to every request, for example to authenticate with the webhook.
+	// Their values are returned as REDACTED.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Signs every request, in the Jetbridge-Signature header. It is never
+	// returned.
+	SigningSecret string `protobuf:"bytes,3,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// How long to wait for the webhook to respond before retrying its messages.
+	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Allows the url to use plain http, sending messages unencrypted.
+	Insecure bool `protobuf:"varint,5,opt,name=insecure,proto3" json:"insecure,omitempty"`
+}
+
+func (x *WebhookTarget) Reset() {
+	*x = WebhookTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTarget) ProtoMessage() {}
+
+func (x *WebhookTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTarget.ProtoReflect.Descriptor instead.
+func (*WebhookTarget) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WebhookTarget) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookTarget) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *WebhookTarget) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *WebhookTarget) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *WebhookTarget) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+// NatsTarget sends messages as requests to a NATS subject, such as that of a
+// NATS service, and waits for the reply.
+type NatsTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *NatsTarget) Reset() {
+	*x = NatsTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatsTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatsTarget) ProtoMessage() {}
+
+func (x *NatsTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatsTarget.ProtoReflect.Descriptor instead.
+func (*NatsTarget) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NatsTarget) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitialDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	Multiplier   float64              `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	MaxDelay     *durationpb.Duration `protobuf:"bytes,3,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	Jitter       float64              `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RetryPolicy) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+// PartitionKey selects the value a binding's messages are partitioned by.
+// Messages sharing a key are dispatched in order, while different keys are
+// dispatched in parallel. Leaving the key unset disables partitioning.
+type PartitionKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*PartitionKey_SubjectToken
+	//	*PartitionKey_Header
+	Key isPartitionKey_Key `protobuf_oneof:"key"`
+}
+
+func (x *PartitionKey) Reset() {
+	*x = PartitionKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionKey) ProtoMessage() {}
+
+func (x *PartitionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *JetstreamBinding) GetMaxBatchLatency() *durationpb.Duration {
-	if x != nil {
-		return x.MaxBatchLatency
-	}
-	return nil
+// Deprecated: Use PartitionKey.ProtoReflect.Descriptor instead.
+func (*PartitionKey) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{27}
 }
 
-func (m *JetstreamBinding) GetDeliveryPolicy() isJetstreamBinding_DeliveryPolicy {
+func (m *PartitionKey) GetKey() isPartitionKey_Key {
 	if m != nil {
-		return m.DeliveryPolicy
+		return m.Key
 	}
 	return nil
 }
 
-func (x *JetstreamBinding) GetPolicy() string {
-	if x, ok := x.GetDeliveryPolicy().(*JetstreamBinding_Policy); ok {
-		return x.Policy
+func (x *PartitionKey) GetSubjectToken() uint32 {
+	if x, ok := x.GetKey().(*PartitionKey_SubjectToken); ok {
+		return x.SubjectToken
 	}
-	return ""
+	return 0
 }
 
-func (x *JetstreamBinding) GetStartTime() *timestamppb.Timestamp {
-	if x, ok := x.GetDeliveryPolicy().(*JetstreamBinding_StartTime); ok {
-		return x.StartTime
+func (x *PartitionKey) GetHeader() string {
+	if x, ok := x.GetKey().(*PartitionKey_Header); ok {
+		return x.Header
 	}
-	return nil
+	return ""
 }
 
-func (x *JetstreamBinding) GetStartSequence() uint64 {
-	if x, ok := x.GetDeliveryPolicy().(*JetstreamBinding_StartSequence); ok {
-		return x.StartSequence
-	}
-	return 0
+type isPartitionKey_Key interface {
+	isPartitionKey_Key()
 }
 
-func (x *JetstreamBinding) GetAssignedPeer() string {
-	if x != nil {
-		return x.AssignedPeer
-	}
-	return ""
+type PartitionKey_SubjectToken struct {
+	// The 1-based index of a subject token, e.g. 2 for orders.<customerID>.*
+	SubjectToken uint32 `protobuf:"varint,1,opt,name=subject_token,json=subjectToken,proto3,oneof"`
 }
 
-type isJetstreamBinding_DeliveryPolicy interface {
-	isJetstreamBinding_DeliveryPolicy()
+type PartitionKey_Header struct {
+	// The name of a message header.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3,oneof"`
 }
 
-type JetstreamBinding_Policy struct {
-	Policy string `protobuf:"bytes,8,opt,name=policy,proto3,oneof"`
+func (*PartitionKey_SubjectToken) isPartitionKey_Key() {}
+
+func (*PartitionKey_Header) isPartitionKey_Key() {}
+
+type Labels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-type JetstreamBinding_StartTime struct {
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,oneof"`
+func (x *Labels) Reset() {
+	*x = Labels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_jetbridge_v1_v1_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type JetstreamBinding_StartSequence struct {
-	StartSequence uint64 `protobuf:"varint,10,opt,name=start_sequence,json=startSequence,proto3,oneof"`
+func (x *Labels) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JetstreamBinding_Policy) isJetstreamBinding_DeliveryPolicy() {}
+func (*Labels) ProtoMessage() {}
 
-func (*JetstreamBinding_StartTime) isJetstreamBinding_DeliveryPolicy() {}
+func (x *Labels) ProtoReflect() protoreflect.Message {
+	mi := &file_jetbridge_v1_v1_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*JetstreamBinding_StartSequence) isJetstreamBinding_DeliveryPolicy() {}
+// Deprecated: Use Labels.ProtoReflect.Descriptor instead.
+func (*Labels) Descriptor() ([]byte, []int) {
+	return file_jetbridge_v1_v1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Labels) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
 
 var File_jetbridge_v1_v1_proto protoreflect.FileDescriptor
 
@@ -806,132 +2277,474 @@ var file_jetbridge_v1_v1_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69,
 	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x22, 0x88, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
-	0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x41, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x0f, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x24, 0x0a,
-	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6f,
+	0x72, 0x73, 0x22, 0xcd, 0x08, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0a, 0x6c,
+	0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x41, 0x72, 0x6e, 0x12, 0x1f,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x30, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x2d, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x4f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x27, 0xfa, 0x42, 0x24, 0x72, 0x22, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x2d, 0x70, 0x65, 0x72, 0x2d, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
 	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
 	0x65, 0x12, 0x27, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
 	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x51, 0x0a,
-	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
-	0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
-	0x0a, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x02, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
-	0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x68, 0x01, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
-	0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01,
-	0x02, 0x08, 0x01, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2,
-	0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x3f,
-	0x0a, 0x0d, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x44, 0x75, 0x65, 0x22,
-	0x87, 0x04, 0x0a, 0x10, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26,
-	0x0a, 0x0a, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x6d,
-	0x62, 0x64, 0x61, 0x41, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x75,
-	0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x45, 0x0a,
+	0x72, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65,
+	0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4f, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x22, 0x05, 0x28, 0x00, 0x18, 0x80, 0x02, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3f,
+	0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65,
+	0x79, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12,
+	0x5f, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x2c, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4c,
+	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x41, 0x0a, 0x13,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x11, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x51, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a,
+	0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65,
+	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0xc4, 0x07, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a,
 	0x11, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
-	0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x74,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0f, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x33,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x48,
+	0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x54, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x48, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x22, 0x05, 0x28, 0x00, 0x18, 0x80,
+	0x02, 0x48, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6a,
+	0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x48, 0x06, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x6e,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x6d, 0x62,
+	0x64, 0x61, 0x5f, 0x61, 0x72, 0x6e, 0x22, 0x51, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x14, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a,
+	0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x51, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x92, 0x03, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x68, 0x01,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x6a, 0x6f,
+	0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0d, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0e, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08,
-	0x72, 0x06, 0xd0, 0x01, 0x01, 0xb0, 0x01, 0x01, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x32, 0xc6, 0x03, 0x0a, 0x10, 0x4a, 0x65,
-	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e,
-	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6a, 0x65,
-	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x65,
-	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x22, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6a, 0x65, 0x74, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
-	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x2e,
-	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x44, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x09, 0x0a, 0x10, 0x4a, 0x65, 0x74, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0a, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61,
+	0x5f, 0x61, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09,
+	0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x41, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x45, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xfa, 0x42, 0x24, 0x72, 0x22, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x2d,
+	0x70, 0x65, 0x72, 0x2d, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x03, 0x6e, 0x65, 0x77,
+	0x48, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x30, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xb0, 0x01,
+	0x01, 0xd0, 0x01, 0x01, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x61,
+	0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x45, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e,
+	0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x5b, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6a, 0x65, 0x74, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x65, 0x74, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x36, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x9a, 0x03, 0x0a, 0x0d, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x27, 0x0a, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x09, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x3d,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x72, 0x06, 0xb0, 0x01, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x90, 0x02, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x63,
+	0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f,
+	0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x43, 0x0a, 0x1e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x6c, 0x61, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0xcd, 0x01, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x6c, 0x61,
+	0x6d, 0x62, 0x64, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x65, 0x74,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61,
+	0x12, 0x37, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x00,
+	0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x74, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
+	0x58, 0x0a, 0x0c, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x2a, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x72, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xad, 0x02, 0x0a, 0x0d, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88,
+	0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x42, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x0a, 0x4e, 0x61, 0x74,
+	0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x0b, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x48, 0x0a, 0x0d, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x29, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52,
+	0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x7d, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x65,
+	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x2a, 0x8f, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e,
+	0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x4f, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e,
+	0x10, 0x03, 0x2a, 0x9e, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x45, 0x54, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x4c,
+	0x4f, 0x55, 0x44, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x41,
+	0x57, 0x10, 0x04, 0x32, 0xbc, 0x06, 0x0a, 0x10, 0x4a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6a, 0x65, 0x74, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6a, 0x65, 0x74,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x22, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e,
+	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x6a,
+	0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e,
+	0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x22, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
 	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x69, 0x6e,
 	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6a, 0x65,
-	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
 	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x4a, 0x6f, 0x65, 0x52, 0x65, 0x69, 0x64, 0x2f, 0x6a, 0x65, 0x74, 0x62, 0x72, 0x69, 0x64,
@@ -952,50 +2765,119 @@ func file_jetbridge_v1_v1_proto_rawDescGZIP() []byte {
 	return file_jetbridge_v1_v1_proto_rawDescData
 }
 
-var file_jetbridge_v1_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_jetbridge_v1_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_jetbridge_v1_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_jetbridge_v1_v1_proto_goTypes = []interface{}{
-	(*ListPeersRequest)(nil),      // 0: jetbridge.v1.ListPeersRequest
-	(*ListPeersResponse)(nil),     // 1: jetbridge.v1.ListPeersResponse
-	(*CreateBindingRequest)(nil),  // 2: jetbridge.v1.CreateBindingRequest
-	(*CreateBindingResponse)(nil), // 3: jetbridge.v1.CreateBindingResponse
-	(*GetBindingRequest)(nil),     // 4: jetbridge.v1.GetBindingRequest
-	(*GetBindingResponse)(nil),    // 5: jetbridge.v1.GetBindingResponse
-	(*ListBindingsRequest)(nil),   // 6: jetbridge.v1.ListBindingsRequest
-	(*ListBindingsResponse)(nil),  // 7: jetbridge.v1.ListBindingsResponse
-	(*DeleteBindingRequest)(nil),  // 8: jetbridge.v1.DeleteBindingRequest
-	(*DeleteBindingResponse)(nil), // 9: jetbridge.v1.DeleteBindingResponse
-	(*Peer)(nil),                  // 10: jetbridge.v1.Peer
-	(*JetstreamBinding)(nil),      // 11: jetbridge.v1.JetstreamBinding
-	(*durationpb.Duration)(nil),   // 12: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(InvocationType)(0),              // 0: jetbridge.v1.InvocationType
+	(PayloadFormat)(0),               // 1: jetbridge.v1.PayloadFormat
+	(*ListPeersRequest)(nil),         // 2: jetbridge.v1.ListPeersRequest
+	(*ListPeersResponse)(nil),        // 3: jetbridge.v1.ListPeersResponse
+	(*CreateBindingRequest)(nil),     // 4: jetbridge.v1.CreateBindingRequest
+	(*CreateBindingResponse)(nil),    // 5: jetbridge.v1.CreateBindingResponse
+	(*GetBindingRequest)(nil),        // 6: jetbridge.v1.GetBindingRequest
+	(*GetBindingResponse)(nil),       // 7: jetbridge.v1.GetBindingResponse
+	(*ListBindingsRequest)(nil),      // 8: jetbridge.v1.ListBindingsRequest
+	(*ListBindingsResponse)(nil),     // 9: jetbridge.v1.ListBindingsResponse
+	(*UpdateBindingRequest)(nil),     // 10: jetbridge.v1.UpdateBindingRequest
+	(*UpdateBindingResponse)(nil),    // 11: jetbridge.v1.UpdateBindingResponse
+	(*DeleteBindingRequest)(nil),     // 12: jetbridge.v1.DeleteBindingRequest
+	(*DeleteBindingResponse)(nil),    // 13: jetbridge.v1.DeleteBindingResponse
+	(*GetBindingStatusRequest)(nil),  // 14: jetbridge.v1.GetBindingStatusRequest
+	(*GetBindingStatusResponse)(nil), // 15: jetbridge.v1.GetBindingStatusResponse
+	(*PauseBindingRequest)(nil),      // 16: jetbridge.v1.PauseBindingRequest
+	(*PauseBindingResponse)(nil),     // 17: jetbridge.v1.PauseBindingResponse
+	(*ResumeBindingRequest)(nil),     // 18: jetbridge.v1.ResumeBindingRequest
+	(*ResumeBindingResponse)(nil),    // 19: jetbridge.v1.ResumeBindingResponse
+	(*Peer)(nil),                     // 20: jetbridge.v1.Peer
+	(*JetstreamBinding)(nil),         // 21: jetbridge.v1.JetstreamBinding
+	(*BindingStatus)(nil),            // 22: jetbridge.v1.BindingStatus
+	(*ConsumerStatus)(nil),           // 23: jetbridge.v1.ConsumerStatus
+	(*Target)(nil),                   // 24: jetbridge.v1.Target
+	(*LambdaTarget)(nil),             // 25: jetbridge.v1.LambdaTarget
+	(*WebhookTarget)(nil),            // 26: jetbridge.v1.WebhookTarget
+	(*NatsTarget)(nil),               // 27: jetbridge.v1.NatsTarget
+	(*RetryPolicy)(nil),              // 28: jetbridge.v1.RetryPolicy
+	(*PartitionKey)(nil),             // 29: jetbridge.v1.PartitionKey
+	(*Labels)(nil),                   // 30: jetbridge.v1.Labels
+	nil,                              // 31: jetbridge.v1.CreateBindingRequest.RequiredLabelsEntry
+	nil,                              // 32: jetbridge.v1.Peer.LabelsEntry
+	nil,                              // 33: jetbridge.v1.JetstreamBinding.RequiredLabelsEntry
+	nil,                              // 34: jetbridge.v1.WebhookTarget.HeadersEntry
+	nil,                              // 35: jetbridge.v1.Labels.LabelsEntry
+	(*durationpb.Duration)(nil),      // 36: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),    // 37: google.protobuf.Timestamp
 }
 var file_jetbridge_v1_v1_proto_depIdxs = []int32{
-	10, // 0: jetbridge.v1.ListPeersResponse.peers:type_name -> jetbridge.v1.Peer
-	12, // 1: jetbridge.v1.CreateBindingRequest.max_batch_latency:type_name -> google.protobuf.Duration
-	13, // 2: jetbridge.v1.CreateBindingRequest.start_time:type_name -> google.protobuf.Timestamp
-	11, // 3: jetbridge.v1.CreateBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
-	11, // 4: jetbridge.v1.GetBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
-	11, // 5: jetbridge.v1.ListBindingsResponse.bindings:type_name -> jetbridge.v1.JetstreamBinding
-	13, // 6: jetbridge.v1.Peer.joined:type_name -> google.protobuf.Timestamp
-	13, // 7: jetbridge.v1.Peer.last_seen:type_name -> google.protobuf.Timestamp
-	13, // 8: jetbridge.v1.Peer.heartbeat_due:type_name -> google.protobuf.Timestamp
-	12, // 9: jetbridge.v1.JetstreamBinding.max_batch_latency:type_name -> google.protobuf.Duration
-	13, // 10: jetbridge.v1.JetstreamBinding.start_time:type_name -> google.protobuf.Timestamp
-	0,  // 11: jetbridge.v1.JetbridgeService.ListPeers:input_type -> jetbridge.v1.ListPeersRequest
-	2,  // 12: jetbridge.v1.JetbridgeService.CreateBinding:input_type -> jetbridge.v1.CreateBindingRequest
-	4,  // 13: jetbridge.v1.JetbridgeService.GetBinding:input_type -> jetbridge.v1.GetBindingRequest
-	6,  // 14: jetbridge.v1.JetbridgeService.ListBindings:input_type -> jetbridge.v1.ListBindingsRequest
-	8,  // 15: jetbridge.v1.JetbridgeService.DeleteBinding:input_type -> jetbridge.v1.DeleteBindingRequest
-	1,  // 16: jetbridge.v1.JetbridgeService.ListPeers:output_type -> jetbridge.v1.ListPeersResponse
-	3,  // 17: jetbridge.v1.JetbridgeService.CreateBinding:output_type -> jetbridge.v1.CreateBindingResponse
-	5,  // 18: jetbridge.v1.JetbridgeService.GetBinding:output_type -> jetbridge.v1.GetBindingResponse
-	7,  // 19: jetbridge.v1.JetbridgeService.ListBindings:output_type -> jetbridge.v1.ListBindingsResponse
-	9,  // 20: jetbridge.v1.JetbridgeService.DeleteBinding:output_type -> jetbridge.v1.DeleteBindingResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	20, // 0: jetbridge.v1.ListPeersResponse.peers:type_name -> jetbridge.v1.Peer
+	36, // 1: jetbridge.v1.CreateBindingRequest.max_batch_latency:type_name -> google.protobuf.Duration
+	37, // 2: jetbridge.v1.CreateBindingRequest.start_time:type_name -> google.protobuf.Timestamp
+	28, // 3: jetbridge.v1.CreateBindingRequest.retry_policy:type_name -> jetbridge.v1.RetryPolicy
+	0,  // 4: jetbridge.v1.CreateBindingRequest.invocation_type:type_name -> jetbridge.v1.InvocationType
+	29, // 5: jetbridge.v1.CreateBindingRequest.partition_key:type_name -> jetbridge.v1.PartitionKey
+	31, // 6: jetbridge.v1.CreateBindingRequest.required_labels:type_name -> jetbridge.v1.CreateBindingRequest.RequiredLabelsEntry
+	24, // 7: jetbridge.v1.CreateBindingRequest.target:type_name -> jetbridge.v1.Target
+	1,  // 8: jetbridge.v1.CreateBindingRequest.payload_format:type_name -> jetbridge.v1.PayloadFormat
+	21, // 9: jetbridge.v1.CreateBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
+	21, // 10: jetbridge.v1.GetBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
+	21, // 11: jetbridge.v1.ListBindingsResponse.bindings:type_name -> jetbridge.v1.JetstreamBinding
+	36, // 12: jetbridge.v1.UpdateBindingRequest.max_batch_latency:type_name -> google.protobuf.Duration
+	28, // 13: jetbridge.v1.UpdateBindingRequest.retry_policy:type_name -> jetbridge.v1.RetryPolicy
+	0,  // 14: jetbridge.v1.UpdateBindingRequest.invocation_type:type_name -> jetbridge.v1.InvocationType
+	29, // 15: jetbridge.v1.UpdateBindingRequest.partition_key:type_name -> jetbridge.v1.PartitionKey
+	30, // 16: jetbridge.v1.UpdateBindingRequest.required_labels:type_name -> jetbridge.v1.Labels
+	24, // 17: jetbridge.v1.UpdateBindingRequest.target:type_name -> jetbridge.v1.Target
+	1,  // 18: jetbridge.v1.UpdateBindingRequest.payload_format:type_name -> jetbridge.v1.PayloadFormat
+	21, // 19: jetbridge.v1.UpdateBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
+	22, // 20: jetbridge.v1.GetBindingStatusResponse.status:type_name -> jetbridge.v1.BindingStatus
+	21, // 21: jetbridge.v1.PauseBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
+	21, // 22: jetbridge.v1.ResumeBindingResponse.binding:type_name -> jetbridge.v1.JetstreamBinding
+	37, // 23: jetbridge.v1.Peer.joined:type_name -> google.protobuf.Timestamp
+	37, // 24: jetbridge.v1.Peer.last_seen:type_name -> google.protobuf.Timestamp
+	37, // 25: jetbridge.v1.Peer.heartbeat_due:type_name -> google.protobuf.Timestamp
+	32, // 26: jetbridge.v1.Peer.labels:type_name -> jetbridge.v1.Peer.LabelsEntry
+	36, // 27: jetbridge.v1.JetstreamBinding.max_batch_latency:type_name -> google.protobuf.Duration
+	37, // 28: jetbridge.v1.JetstreamBinding.start_time:type_name -> google.protobuf.Timestamp
+	28, // 29: jetbridge.v1.JetstreamBinding.retry_policy:type_name -> jetbridge.v1.RetryPolicy
+	0,  // 30: jetbridge.v1.JetstreamBinding.invocation_type:type_name -> jetbridge.v1.InvocationType
+	29, // 31: jetbridge.v1.JetstreamBinding.partition_key:type_name -> jetbridge.v1.PartitionKey
+	33, // 32: jetbridge.v1.JetstreamBinding.required_labels:type_name -> jetbridge.v1.JetstreamBinding.RequiredLabelsEntry
+	24, // 33: jetbridge.v1.JetstreamBinding.target:type_name -> jetbridge.v1.Target
+	1,  // 34: jetbridge.v1.JetstreamBinding.payload_format:type_name -> jetbridge.v1.PayloadFormat
+	23, // 35: jetbridge.v1.BindingStatus.consumer:type_name -> jetbridge.v1.ConsumerStatus
+	37, // 36: jetbridge.v1.BindingStatus.last_success:type_name -> google.protobuf.Timestamp
+	37, // 37: jetbridge.v1.BindingStatus.last_error:type_name -> google.protobuf.Timestamp
+	37, // 38: jetbridge.v1.BindingStatus.reported:type_name -> google.protobuf.Timestamp
+	25, // 39: jetbridge.v1.Target.lambda:type_name -> jetbridge.v1.LambdaTarget
+	26, // 40: jetbridge.v1.Target.webhook:type_name -> jetbridge.v1.WebhookTarget
+	27, // 41: jetbridge.v1.Target.nats:type_name -> jetbridge.v1.NatsTarget
+	34, // 42: jetbridge.v1.WebhookTarget.headers:type_name -> jetbridge.v1.WebhookTarget.HeadersEntry
+	36, // 43: jetbridge.v1.WebhookTarget.timeout:type_name -> google.protobuf.Duration
+	36, // 44: jetbridge.v1.RetryPolicy.initial_delay:type_name -> google.protobuf.Duration
+	36, // 45: jetbridge.v1.RetryPolicy.max_delay:type_name -> google.protobuf.Duration
+	35, // 46: jetbridge.v1.Labels.labels:type_name -> jetbridge.v1.Labels.LabelsEntry
+	2,  // 47: jetbridge.v1.JetbridgeService.ListPeers:input_type -> jetbridge.v1.ListPeersRequest
+	4,  // 48: jetbridge.v1.JetbridgeService.CreateBinding:input_type -> jetbridge.v1.CreateBindingRequest
+	6,  // 49: jetbridge.v1.JetbridgeService.GetBinding:input_type -> jetbridge.v1.GetBindingRequest
+	8,  // 50: jetbridge.v1.JetbridgeService.ListBindings:input_type -> jetbridge.v1.ListBindingsRequest
+	10, // 51: jetbridge.v1.JetbridgeService.UpdateBinding:input_type -> jetbridge.v1.UpdateBindingRequest
+	12, // 52: jetbridge.v1.JetbridgeService.DeleteBinding:input_type -> jetbridge.v1.DeleteBindingRequest
+	14, // 53: jetbridge.v1.JetbridgeService.GetBindingStatus:input_type -> jetbridge.v1.GetBindingStatusRequest
+	16, // 54: jetbridge.v1.JetbridgeService.PauseBinding:input_type -> jetbridge.v1.PauseBindingRequest
+	18, // 55: jetbridge.v1.JetbridgeService.ResumeBinding:input_type -> jetbridge.v1.ResumeBindingRequest
+	3,  // 56: jetbridge.v1.JetbridgeService.ListPeers:output_type -> jetbridge.v1.ListPeersResponse
+	5,  // 57: jetbridge.v1.JetbridgeService.CreateBinding:output_type -> jetbridge.v1.CreateBindingResponse
+	7,  // 58: jetbridge.v1.JetbridgeService.GetBinding:output_type -> jetbridge.v1.GetBindingResponse
+	9,  // 59: jetbridge.v1.JetbridgeService.ListBindings:output_type -> jetbridge.v1.ListBindingsResponse
+	11, // 60: jetbridge.v1.JetbridgeService.UpdateBinding:output_type -> jetbridge.v1.UpdateBindingResponse
+	13, // 61: jetbridge.v1.JetbridgeService.DeleteBinding:output_type -> jetbridge.v1.DeleteBindingResponse
+	15, // 62: jetbridge.v1.JetbridgeService.GetBindingStatus:output_type -> jetbridge.v1.GetBindingStatusResponse
+	17, // 63: jetbridge.v1.JetbridgeService.PauseBinding:output_type -> jetbridge.v1.PauseBindingResponse
+	19, // 64: jetbridge.v1.JetbridgeService.ResumeBinding:output_type -> jetbridge.v1.ResumeBindingResponse
+	56, // [56:65] is the sub-list for method output_type
+	47, // [47:56] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_jetbridge_v1_v1_proto_init() }
@@ -1101,7 +2983,7 @@ func file_jetbridge_v1_v1_proto_init() {
 			}
 		}
 		file_jetbridge_v1_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBindingRequest); i {
+			switch v := v.(*UpdateBindingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1113,7 +2995,7 @@ func file_jetbridge_v1_v1_proto_init() {
 			}
 		}
 		file_jetbridge_v1_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBindingResponse); i {
+			switch v := v.(*UpdateBindingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1125,7 +3007,7 @@ func file_jetbridge_v1_v1_proto_init() {
 			}
 		}
 		file_jetbridge_v1_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peer); i {
+			switch v := v.(*DeleteBindingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1137,6 +3019,102 @@ func file_jetbridge_v1_v1_proto_init() {
 			}
 		}
 		file_jetbridge_v1_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBindingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBindingStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBindingStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseBindingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseBindingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeBindingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeBindingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JetstreamBinding); i {
 			case 0:
 				return &v.state
@@ -1148,29 +3126,148 @@ func file_jetbridge_v1_v1_proto_init() {
 				return nil
 			}
 		}
+		file_jetbridge_v1_v1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindingStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LambdaTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NatsTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_jetbridge_v1_v1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Labels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_jetbridge_v1_v1_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*CreateBindingRequest_Policy)(nil),
 		(*CreateBindingRequest_StartTime)(nil),
 		(*CreateBindingRequest_StartSequence)(nil),
 	}
-	file_jetbridge_v1_v1_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_jetbridge_v1_v1_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_jetbridge_v1_v1_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*JetstreamBinding_Policy)(nil),
 		(*JetstreamBinding_StartTime)(nil),
 		(*JetstreamBinding_StartSequence)(nil),
 	}
+	file_jetbridge_v1_v1_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*Target_Lambda)(nil),
+		(*Target_Webhook)(nil),
+		(*Target_Nats)(nil),
+	}
+	file_jetbridge_v1_v1_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*PartitionKey_SubjectToken)(nil),
+		(*PartitionKey_Header)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_jetbridge_v1_v1_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_jetbridge_v1_v1_proto_goTypes,
 		DependencyIndexes: file_jetbridge_v1_v1_proto_depIdxs,
+		EnumInfos:         file_jetbridge_v1_v1_proto_enumTypes,
 		MessageInfos:      file_jetbridge_v1_v1_proto_msgTypes,
 	}.Build()
 	File_jetbridge_v1_v1_proto = out.File
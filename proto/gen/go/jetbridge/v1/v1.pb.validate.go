@@ -320,14 +320,66 @@ func (m *CreateBindingRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for MaxBatchSize
+	if m.GetMaxBatchSize() < 0 {
+		err := CreateBindingRequestValidationError{
+			field:  "MaxBatchSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetMaxBatchLatency(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateBindingRequestValidationError{
+				field:  "MaxBatchLatency",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := CreateBindingRequestValidationError{
+					field:  "MaxBatchLatency",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetMaxDeliveries() < 0 {
+		err := CreateBindingRequestValidationError{
+			field:  "MaxDeliveries",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeadLetterSubject
 
 	if all {
-		switch v := interface{}(m.GetMaxBatchLatency()).(type) {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, CreateBindingRequestValidationError{
-					field:  "MaxBatchLatency",
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
@@ -335,22 +387,115 @@ func (m *CreateBindingRequest) validate(all bool) error {
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
 				errors = append(errors, CreateBindingRequestValidationError{
-					field:  "MaxBatchLatency",
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMaxBatchLatency()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return CreateBindingRequestValidationError{
-				field:  "MaxBatchLatency",
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := InvocationType_name[int32(m.GetInvocationType())]; !ok {
+		err := CreateBindingRequestValidationError{
+			field:  "InvocationType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxConcurrency(); val < 0 || val > 256 {
+		err := CreateBindingRequestValidationError{
+			field:  "MaxConcurrency",
+			reason: "value must be inside range [0, 256]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPartitionKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBindingRequestValidationError{
+					field:  "PartitionKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBindingRequestValidationError{
+					field:  "PartitionKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPartitionKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBindingRequestValidationError{
+				field:  "PartitionKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequiredLabels
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBindingRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBindingRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBindingRequestValidationError{
+				field:  "Target",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if _, ok := PayloadFormat_name[int32(m.GetPayloadFormat())]; !ok {
+		err := CreateBindingRequestValidationError{
+			field:  "PayloadFormat",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	switch v := m.DeliveryPolicy.(type) {
 	case *CreateBindingRequest_Policy:
 		if v == nil {
@@ -363,7 +508,18 @@ func (m *CreateBindingRequest) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
-		// no validation rules for Policy
+
+		if _, ok := _CreateBindingRequest_Policy_InLookup[m.GetPolicy()]; !ok {
+			err := CreateBindingRequestValidationError{
+				field:  "Policy",
+				reason: "value must be in list [all last last-per-subject new]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	case *CreateBindingRequest_StartTime:
 		if v == nil {
 			err := CreateBindingRequestValidationError{
@@ -501,6 +657,13 @@ var _ interface {
 	ErrorName() string
 } = CreateBindingRequestValidationError{}
 
+var _CreateBindingRequest_Policy_InLookup = map[string]struct{}{
+	"all":              {},
+	"last":             {},
+	"last-per-subject": {},
+	"new":              {},
+}
+
 // Validate checks the field values on CreateBindingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1123,22 +1286,22 @@ var _ interface {
 	ErrorName() string
 } = ListBindingsResponseValidationError{}
 
-// Validate checks the field values on DeleteBindingRequest with the rules
+// Validate checks the field values on UpdateBindingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBindingRequest) Validate() error {
+func (m *UpdateBindingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBindingRequest with the rules
+// ValidateAll checks the field values on UpdateBindingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBindingRequestMultiError, or nil if none found.
-func (m *DeleteBindingRequest) ValidateAll() error {
+// UpdateBindingRequestMultiError, or nil if none found.
+func (m *UpdateBindingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBindingRequest) validate(all bool) error {
+func (m *UpdateBindingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1146,7 +1309,7 @@ func (m *DeleteBindingRequest) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetId()); err != nil {
-		err = DeleteBindingRequestValidationError{
+		err = UpdateBindingRequestValidationError{
 			field:  "Id",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -1157,130 +1320,268 @@ func (m *DeleteBindingRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return DeleteBindingRequestMultiError(errors)
-	}
+	if d := m.GetMaxBatchLatency(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateBindingRequestValidationError{
+				field:  "MaxBatchLatency",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
 
-	return nil
-}
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
 
-func (m *DeleteBindingRequest) _validateUuid(uuid string) error {
-	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
-	}
+			if dur < gte {
+				err := UpdateBindingRequestValidationError{
+					field:  "MaxBatchLatency",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
 
-	return nil
-}
+		}
+	}
 
-// DeleteBindingRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteBindingRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBindingRequestMultiError []error
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBindingRequestValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBindingRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetPartitionKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "PartitionKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "PartitionKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPartitionKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBindingRequestValidationError{
+				field:  "PartitionKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m DeleteBindingRequestMultiError) AllErrors() []error { return m }
+	if all {
+		switch v := interface{}(m.GetRequiredLabels()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "RequiredLabels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "RequiredLabels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequiredLabels()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBindingRequestValidationError{
+				field:  "RequiredLabels",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// DeleteBindingRequestValidationError is the validation error returned by
-// DeleteBindingRequest.Validate if the designated constraints aren't met.
-type DeleteBindingRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBindingRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBindingRequestValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// Field function returns field value.
-func (e DeleteBindingRequestValidationError) Field() string { return e.field }
+	if m.SubjectPattern != nil {
 
-// Reason function returns reason value.
-func (e DeleteBindingRequestValidationError) Reason() string { return e.reason }
+		if utf8.RuneCountInString(m.GetSubjectPattern()) < 1 {
+			err := UpdateBindingRequestValidationError{
+				field:  "SubjectPattern",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Cause function returns cause value.
-func (e DeleteBindingRequestValidationError) Cause() error { return e.cause }
+	}
 
-// Key function returns key value.
-func (e DeleteBindingRequestValidationError) Key() bool { return e.key }
+	if m.MaxBatchSize != nil {
 
-// ErrorName returns error name.
-func (e DeleteBindingRequestValidationError) ErrorName() string {
-	return "DeleteBindingRequestValidationError"
-}
+		if m.GetMaxBatchSize() < 0 {
+			err := UpdateBindingRequestValidationError{
+				field:  "MaxBatchSize",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error satisfies the builtin error interface
-func (e DeleteBindingRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
+	if m.MaxDeliveries != nil {
+
+		if m.GetMaxDeliveries() < 0 {
+			err := UpdateBindingRequestValidationError{
+				field:  "MaxDeliveries",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	return fmt.Sprintf(
-		"invalid %sDeleteBindingRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
+	if m.DeadLetterSubject != nil {
+		// no validation rules for DeadLetterSubject
+	}
 
-var _ error = DeleteBindingRequestValidationError{}
+	if m.InvocationType != nil {
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = DeleteBindingRequestValidationError{}
+		if _, ok := InvocationType_name[int32(m.GetInvocationType())]; !ok {
+			err := UpdateBindingRequestValidationError{
+				field:  "InvocationType",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Validate checks the field values on DeleteBindingResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBindingResponse) Validate() error {
-	return m.validate(false)
-}
+	}
 
-// ValidateAll checks the field values on DeleteBindingResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteBindingResponseMultiError, or nil if none found.
-func (m *DeleteBindingResponse) ValidateAll() error {
-	return m.validate(true)
-}
+	if m.MaxConcurrency != nil {
+
+		if val := m.GetMaxConcurrency(); val < 0 || val > 256 {
+			err := UpdateBindingRequestValidationError{
+				field:  "MaxConcurrency",
+				reason: "value must be inside range [0, 256]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-func (m *DeleteBindingResponse) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
+	if m.PayloadFormat != nil {
+
+		if _, ok := PayloadFormat_name[int32(m.GetPayloadFormat())]; !ok {
+			err := UpdateBindingRequestValidationError{
+				field:  "PayloadFormat",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteBindingResponseMultiError(errors)
+		return UpdateBindingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBindingResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteBindingResponse.ValidateAll() if the designated
+func (m *UpdateBindingRequest) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBindingRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteBindingResponseMultiError []error
+type UpdateBindingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBindingResponseMultiError) Error() string {
+func (m UpdateBindingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1289,11 +1590,11 @@ func (m DeleteBindingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBindingResponseMultiError) AllErrors() []error { return m }
+func (m UpdateBindingRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBindingResponseValidationError is the validation error returned by
-// DeleteBindingResponse.Validate if the designated constraints aren't met.
-type DeleteBindingResponseValidationError struct {
+// UpdateBindingRequestValidationError is the validation error returned by
+// UpdateBindingRequest.Validate if the designated constraints aren't met.
+type UpdateBindingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1301,24 +1602,24 @@ type DeleteBindingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBindingResponseValidationError) Field() string { return e.field }
+func (e UpdateBindingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBindingResponseValidationError) Reason() string { return e.reason }
+func (e UpdateBindingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBindingResponseValidationError) Cause() error { return e.cause }
+func (e UpdateBindingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBindingResponseValidationError) Key() bool { return e.key }
+func (e UpdateBindingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBindingResponseValidationError) ErrorName() string {
-	return "DeleteBindingResponseValidationError"
+func (e UpdateBindingRequestValidationError) ErrorName() string {
+	return "UpdateBindingRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBindingResponseValidationError) Error() string {
+func (e UpdateBindingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1330,14 +1631,14 @@ func (e DeleteBindingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBindingResponse.%s: %s%s",
+		"invalid %sUpdateBindingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBindingResponseValidationError{}
+var _ error = UpdateBindingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1345,98 +1646,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBindingResponseValidationError{}
+} = UpdateBindingRequestValidationError{}
 
-// Validate checks the field values on Peer with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Peer) Validate() error {
+// Validate checks the field values on UpdateBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBindingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Peer with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in PeerMultiError, or nil if none found.
-func (m *Peer) ValidateAll() error {
+// ValidateAll checks the field values on UpdateBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBindingResponseMultiError, or nil if none found.
+func (m *UpdateBindingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Peer) validate(all bool) error {
+func (m *UpdateBindingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = PeerValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateHostname(m.GetHostname()); err != nil {
-		err = PeerValidationError{
-			field:  "Hostname",
-			reason: "value must be a valid hostname",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetJoined() == nil {
-		err := PeerValidationError{
-			field:  "Joined",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetLastSeen() == nil {
-		err := PeerValidationError{
-			field:  "LastSeen",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetHeartbeatDue()).(type) {
+		switch v := interface{}(m.GetBinding()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PeerValidationError{
-					field:  "HeartbeatDue",
+				errors = append(errors, UpdateBindingResponseValidationError{
+					field:  "Binding",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PeerValidationError{
-					field:  "HeartbeatDue",
+				errors = append(errors, UpdateBindingResponseValidationError{
+					field:  "Binding",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetHeartbeatDue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBinding()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PeerValidationError{
-				field:  "HeartbeatDue",
+			return UpdateBindingResponseValidationError{
+				field:  "Binding",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1444,56 +1700,19 @@ func (m *Peer) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PeerMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *Peer) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return UpdateBindingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *Peer) _validateUuid(uuid string) error {
-	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
-	}
-
-	return nil
-}
-
-// PeerMultiError is an error wrapping multiple validation errors returned by
-// Peer.ValidateAll() if the designated constraints aren't met.
-type PeerMultiError []error
+// UpdateBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBindingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBindingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PeerMultiError) Error() string {
+func (m UpdateBindingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1502,11 +1721,11 @@ func (m PeerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PeerMultiError) AllErrors() []error { return m }
+func (m UpdateBindingResponseMultiError) AllErrors() []error { return m }
 
-// PeerValidationError is the validation error returned by Peer.Validate if the
-// designated constraints aren't met.
-type PeerValidationError struct {
+// UpdateBindingResponseValidationError is the validation error returned by
+// UpdateBindingResponse.Validate if the designated constraints aren't met.
+type UpdateBindingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1514,22 +1733,24 @@ type PeerValidationError struct {
 }
 
 // Field function returns field value.
-func (e PeerValidationError) Field() string { return e.field }
+func (e UpdateBindingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PeerValidationError) Reason() string { return e.reason }
+func (e UpdateBindingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PeerValidationError) Cause() error { return e.cause }
+func (e UpdateBindingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PeerValidationError) Key() bool { return e.key }
+func (e UpdateBindingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PeerValidationError) ErrorName() string { return "PeerValidationError" }
+func (e UpdateBindingResponseValidationError) ErrorName() string {
+	return "UpdateBindingResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PeerValidationError) Error() string {
+func (e UpdateBindingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1541,14 +1762,14 @@ func (e PeerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPeer.%s: %s%s",
+		"invalid %sUpdateBindingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PeerValidationError{}
+var _ error = UpdateBindingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1556,24 +1777,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PeerValidationError{}
+} = UpdateBindingResponseValidationError{}
 
-// Validate checks the field values on JetstreamBinding with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JetstreamBinding) Validate() error {
-	return m.validate(false)
+// Validate checks the field values on DeleteBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBindingRequest) Validate() error {
+	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JetstreamBinding with the rules
+// ValidateAll checks the field values on DeleteBindingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JetstreamBindingMultiError, or nil if none found.
-func (m *JetstreamBinding) ValidateAll() error {
+// DeleteBindingRequestMultiError, or nil if none found.
+func (m *DeleteBindingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JetstreamBinding) validate(all bool) error {
+func (m *DeleteBindingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1581,7 +1802,7 @@ func (m *JetstreamBinding) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetId()); err != nil {
-		err = JetstreamBindingValidationError{
+		err = DeleteBindingRequestValidationError{
 			field:  "Id",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -1592,10 +1813,223 @@ func (m *JetstreamBinding) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetLambdaArn()) < 1 {
-		err := JetstreamBindingValidationError{
-			field:  "LambdaArn",
-			reason: "value length must be at least 1 runes",
+	if len(errors) > 0 {
+		return DeleteBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteBindingRequest) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBindingRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBindingRequestValidationError is the validation error returned by
+// DeleteBindingRequest.Validate if the designated constraints aren't met.
+type DeleteBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBindingRequestValidationError) ErrorName() string {
+	return "DeleteBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBindingRequestValidationError{}
+
+// Validate checks the field values on DeleteBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBindingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBindingResponseMultiError, or nil if none found.
+func (m *DeleteBindingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBindingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBindingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBindingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBindingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBindingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBindingResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBindingResponseValidationError is the validation error returned by
+// DeleteBindingResponse.Validate if the designated constraints aren't met.
+type DeleteBindingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBindingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBindingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBindingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBindingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBindingResponseValidationError) ErrorName() string {
+	return "DeleteBindingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBindingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBindingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBindingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBindingResponseValidationError{}
+
+// Validate checks the field values on GetBindingStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBindingStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBindingStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBindingStatusRequestMultiError, or nil if none found.
+func (m *GetBindingStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBindingStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetBindingStatusRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -1603,21 +2037,2430 @@ func (m *JetstreamBinding) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetStream()) < 1 {
-		err := JetstreamBindingValidationError{
-			field:  "Stream",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	if len(errors) > 0 {
+		return GetBindingStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetBindingStatusRequest) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetBindingStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBindingStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBindingStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBindingStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBindingStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetBindingStatusRequestValidationError is the validation error returned by
+// GetBindingStatusRequest.Validate if the designated constraints aren't met.
+type GetBindingStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBindingStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBindingStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBindingStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBindingStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBindingStatusRequestValidationError) ErrorName() string {
+	return "GetBindingStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBindingStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBindingStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBindingStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBindingStatusRequestValidationError{}
+
+// Validate checks the field values on GetBindingStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBindingStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBindingStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBindingStatusResponseMultiError, or nil if none found.
+func (m *GetBindingStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBindingStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBindingStatusResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBindingStatusResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBindingStatusResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBindingStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBindingStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBindingStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBindingStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBindingStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBindingStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetBindingStatusResponseValidationError is the validation error returned by
+// GetBindingStatusResponse.Validate if the designated constraints aren't met.
+type GetBindingStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBindingStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBindingStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBindingStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBindingStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBindingStatusResponseValidationError) ErrorName() string {
+	return "GetBindingStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBindingStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBindingStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBindingStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBindingStatusResponseValidationError{}
+
+// Validate checks the field values on PauseBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseBindingRequestMultiError, or nil if none found.
+func (m *PauseBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseBindingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = PauseBindingRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PauseBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PauseBindingRequest) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PauseBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseBindingRequestMultiError) AllErrors() []error { return m }
+
+// PauseBindingRequestValidationError is the validation error returned by
+// PauseBindingRequest.Validate if the designated constraints aren't met.
+type PauseBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseBindingRequestValidationError) ErrorName() string {
+	return "PauseBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseBindingRequestValidationError{}
+
+// Validate checks the field values on PauseBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseBindingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseBindingResponseMultiError, or nil if none found.
+func (m *PauseBindingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseBindingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBinding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PauseBindingResponseValidationError{
+					field:  "Binding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PauseBindingResponseValidationError{
+					field:  "Binding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBinding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PauseBindingResponseValidationError{
+				field:  "Binding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PauseBindingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by PauseBindingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PauseBindingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseBindingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseBindingResponseMultiError) AllErrors() []error { return m }
+
+// PauseBindingResponseValidationError is the validation error returned by
+// PauseBindingResponse.Validate if the designated constraints aren't met.
+type PauseBindingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseBindingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseBindingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseBindingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseBindingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseBindingResponseValidationError) ErrorName() string {
+	return "PauseBindingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseBindingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseBindingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseBindingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseBindingResponseValidationError{}
+
+// Validate checks the field values on ResumeBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeBindingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeBindingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeBindingRequestMultiError, or nil if none found.
+func (m *ResumeBindingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeBindingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ResumeBindingRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeBindingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ResumeBindingRequest) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ResumeBindingRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeBindingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeBindingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeBindingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeBindingRequestMultiError) AllErrors() []error { return m }
+
+// ResumeBindingRequestValidationError is the validation error returned by
+// ResumeBindingRequest.Validate if the designated constraints aren't met.
+type ResumeBindingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeBindingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeBindingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeBindingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeBindingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeBindingRequestValidationError) ErrorName() string {
+	return "ResumeBindingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeBindingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeBindingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeBindingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeBindingRequestValidationError{}
+
+// Validate checks the field values on ResumeBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeBindingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeBindingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeBindingResponseMultiError, or nil if none found.
+func (m *ResumeBindingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeBindingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBinding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResumeBindingResponseValidationError{
+					field:  "Binding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResumeBindingResponseValidationError{
+					field:  "Binding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBinding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResumeBindingResponseValidationError{
+				field:  "Binding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResumeBindingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeBindingResponseMultiError is an error wrapping multiple validation
+// errors returned by ResumeBindingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeBindingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeBindingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeBindingResponseMultiError) AllErrors() []error { return m }
+
+// ResumeBindingResponseValidationError is the validation error returned by
+// ResumeBindingResponse.Validate if the designated constraints aren't met.
+type ResumeBindingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeBindingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeBindingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeBindingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeBindingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeBindingResponseValidationError) ErrorName() string {
+	return "ResumeBindingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeBindingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeBindingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeBindingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeBindingResponseValidationError{}
+
+// Validate checks the field values on Peer with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Peer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Peer with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PeerMultiError, or nil if none found.
+func (m *Peer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Peer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = PeerValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHostname()); err != nil {
+		err = PeerValidationError{
+			field:  "Hostname",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetJoined() == nil {
+		err := PeerValidationError{
+			field:  "Joined",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastSeen() == nil {
+		err := PeerValidationError{
+			field:  "LastSeen",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetHeartbeatDue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PeerValidationError{
+					field:  "HeartbeatDue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PeerValidationError{
+					field:  "HeartbeatDue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHeartbeatDue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeerValidationError{
+				field:  "HeartbeatDue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Weight
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return PeerMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Peer) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Peer) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// PeerMultiError is an error wrapping multiple validation errors returned by
+// Peer.ValidateAll() if the designated constraints aren't met.
+type PeerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeerMultiError) AllErrors() []error { return m }
+
+// PeerValidationError is the validation error returned by Peer.Validate if the
+// designated constraints aren't met.
+type PeerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerValidationError) ErrorName() string { return "PeerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerValidationError{}
+
+// Validate checks the field values on JetstreamBinding with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JetstreamBinding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JetstreamBinding with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JetstreamBindingMultiError, or nil if none found.
+func (m *JetstreamBinding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JetstreamBinding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = JetstreamBindingValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for LambdaArn
+
+	if utf8.RuneCountInString(m.GetStream()) < 1 {
+		err := JetstreamBindingValidationError{
+			field:  "Stream",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetConsumerName()) < 1 {
+		err := JetstreamBindingValidationError{
+			field:  "ConsumerName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubjectPattern()) < 1 {
+		err := JetstreamBindingValidationError{
+			field:  "SubjectPattern",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MaxBatchSize
+
+	if all {
+		switch v := interface{}(m.GetMaxBatchLatency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "MaxBatchLatency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "MaxBatchLatency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxBatchLatency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JetstreamBindingValidationError{
+				field:  "MaxBatchLatency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAssignedPeer() != "" {
+
+		if err := m._validateUuid(m.GetAssignedPeer()); err != nil {
+			err = JetstreamBindingValidationError{
+				field:  "AssignedPeer",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for MaxDeliveries
+
+	// no validation rules for DeadLetterSubject
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JetstreamBindingValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InvocationType
+
+	// no validation rules for MaxConcurrency
+
+	if all {
+		switch v := interface{}(m.GetPartitionKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "PartitionKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "PartitionKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPartitionKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JetstreamBindingValidationError{
+				field:  "PartitionKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Paused
+
+	// no validation rules for RequiredLabels
+
+	if m.GetTarget() == nil {
+		err := JetstreamBindingValidationError{
+			field:  "Target",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JetstreamBindingValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JetstreamBindingValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PayloadFormat
+
+	switch v := m.DeliveryPolicy.(type) {
+	case *JetstreamBinding_Policy:
+		if v == nil {
+			err := JetstreamBindingValidationError{
+				field:  "DeliveryPolicy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := _JetstreamBinding_Policy_InLookup[m.GetPolicy()]; !ok {
+			err := JetstreamBindingValidationError{
+				field:  "Policy",
+				reason: "value must be in list [all last last-per-subject new]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *JetstreamBinding_StartTime:
+		if v == nil {
+			err := JetstreamBindingValidationError{
+				field:  "DeliveryPolicy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JetstreamBindingValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JetstreamBindingValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JetstreamBindingValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JetstreamBinding_StartSequence:
+		if v == nil {
+			err := JetstreamBindingValidationError{
+				field:  "DeliveryPolicy",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for StartSequence
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JetstreamBindingMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *JetstreamBinding) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// JetstreamBindingMultiError is an error wrapping multiple validation errors
+// returned by JetstreamBinding.ValidateAll() if the designated constraints
+// aren't met.
+type JetstreamBindingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JetstreamBindingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JetstreamBindingMultiError) AllErrors() []error { return m }
+
+// JetstreamBindingValidationError is the validation error returned by
+// JetstreamBinding.Validate if the designated constraints aren't met.
+type JetstreamBindingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JetstreamBindingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JetstreamBindingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JetstreamBindingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JetstreamBindingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JetstreamBindingValidationError) ErrorName() string { return "JetstreamBindingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JetstreamBindingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJetstreamBinding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JetstreamBindingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JetstreamBindingValidationError{}
+
+var _JetstreamBinding_Policy_InLookup = map[string]struct{}{
+	"all":              {},
+	"last":             {},
+	"last-per-subject": {},
+	"new":              {},
+}
+
+// Validate checks the field values on BindingStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BindingStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BindingStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BindingStatusMultiError, or
+// nil if none found.
+func (m *BindingStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BindingStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetBindingId()); err != nil {
+		err = BindingStatusValidationError{
+			field:  "BindingId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Running
+
+	if all {
+		switch v := interface{}(m.GetConsumer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "Consumer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "Consumer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsumer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BindingStatusValidationError{
+				field:  "Consumer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSuccess()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "LastSuccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "LastSuccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSuccess()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BindingStatusValidationError{
+				field:  "LastSuccess",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "LastError",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "LastError",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BindingStatusValidationError{
+				field:  "LastError",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastErrorMessage
+
+	if m.GetReportedBy() != "" {
+
+		if err := m._validateUuid(m.GetReportedBy()); err != nil {
+			err = BindingStatusValidationError{
+				field:  "ReportedBy",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetReported()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "Reported",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BindingStatusValidationError{
+					field:  "Reported",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReported()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BindingStatusValidationError{
+				field:  "Reported",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BindingStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BindingStatus) _validateUuid(uuid string) error {
+	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BindingStatusMultiError is an error wrapping multiple validation errors
+// returned by BindingStatus.ValidateAll() if the designated constraints
+// aren't met.
+type BindingStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BindingStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BindingStatusMultiError) AllErrors() []error { return m }
+
+// BindingStatusValidationError is the validation error returned by
+// BindingStatus.Validate if the designated constraints aren't met.
+type BindingStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BindingStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BindingStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BindingStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BindingStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BindingStatusValidationError) ErrorName() string { return "BindingStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BindingStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBindingStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BindingStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BindingStatusValidationError{}
+
+// Validate checks the field values on ConsumerStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsumerStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsumerStatusMultiError,
+// or nil if none found.
+func (m *ConsumerStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NumPending
+
+	// no validation rules for NumAckPending
+
+	// no validation rules for NumRedelivered
+
+	// no validation rules for LastDeliveredStreamSequence
+
+	// no validation rules for LastDeliveredConsumerSequence
+
+	if len(errors) > 0 {
+		return ConsumerStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerStatusMultiError is an error wrapping multiple validation errors
+// returned by ConsumerStatus.ValidateAll() if the designated constraints
+// aren't met.
+type ConsumerStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerStatusMultiError) AllErrors() []error { return m }
+
+// ConsumerStatusValidationError is the validation error returned by
+// ConsumerStatus.Validate if the designated constraints aren't met.
+type ConsumerStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerStatusValidationError) ErrorName() string { return "ConsumerStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsumerStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerStatusValidationError{}
+
+// Validate checks the field values on Target with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Target) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Target with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TargetMultiError, or nil if none found.
+func (m *Target) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Target) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofTargetPresent := false
+	switch v := m.Target.(type) {
+	case *Target_Lambda:
+		if v == nil {
+			err := TargetValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTargetPresent = true
+
+		if all {
+			switch v := interface{}(m.GetLambda()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetValidationError{
+						field:  "Lambda",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetValidationError{
+						field:  "Lambda",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLambda()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetValidationError{
+					field:  "Lambda",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Target_Webhook:
+		if v == nil {
+			err := TargetValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTargetPresent = true
+
+		if all {
+			switch v := interface{}(m.GetWebhook()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetValidationError{
+						field:  "Webhook",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetValidationError{
+						field:  "Webhook",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Target_Nats:
+		if v == nil {
+			err := TargetValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTargetPresent = true
+
+		if all {
+			switch v := interface{}(m.GetNats()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TargetValidationError{
+						field:  "Nats",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TargetValidationError{
+						field:  "Nats",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNats()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TargetValidationError{
+					field:  "Nats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTargetPresent {
+		err := TargetValidationError{
+			field:  "Target",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// TargetMultiError is an error wrapping multiple validation errors returned by
+// Target.ValidateAll() if the designated constraints aren't met.
+type TargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TargetMultiError) AllErrors() []error { return m }
+
+// TargetValidationError is the validation error returned by Target.Validate if
+// the designated constraints aren't met.
+type TargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TargetValidationError) ErrorName() string { return "TargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TargetValidationError{}
+
+// Validate checks the field values on LambdaTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LambdaTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LambdaTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LambdaTargetMultiError, or
+// nil if none found.
+func (m *LambdaTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LambdaTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFunctionArn()) < 1 {
+		err := LambdaTargetValidationError{
+			field:  "FunctionArn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Qualifier
+
+	if len(errors) > 0 {
+		return LambdaTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// LambdaTargetMultiError is an error wrapping multiple validation errors
+// returned by LambdaTarget.ValidateAll() if the designated constraints aren't met.
+type LambdaTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LambdaTargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LambdaTargetMultiError) AllErrors() []error { return m }
+
+// LambdaTargetValidationError is the validation error returned by
+// LambdaTarget.Validate if the designated constraints aren't met.
+type LambdaTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LambdaTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LambdaTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LambdaTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LambdaTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LambdaTargetValidationError) ErrorName() string { return "LambdaTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LambdaTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLambdaTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LambdaTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LambdaTargetValidationError{}
+
+// Validate checks the field values on WebhookTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookTargetMultiError, or
+// nil if none found.
+func (m *WebhookTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = WebhookTargetValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := WebhookTargetValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Headers
+
+	// no validation rules for SigningSecret
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = WebhookTargetValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := WebhookTargetValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Insecure
+
+	if len(errors) > 0 {
+		return WebhookTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookTargetMultiError is an error wrapping multiple validation errors
+// returned by WebhookTarget.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookTargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookTargetMultiError) AllErrors() []error { return m }
+
+// WebhookTargetValidationError is the validation error returned by
+// WebhookTarget.Validate if the designated constraints aren't met.
+type WebhookTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookTargetValidationError) ErrorName() string { return "WebhookTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookTargetValidationError{}
+
+// Validate checks the field values on NatsTarget with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NatsTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NatsTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NatsTargetMultiError, or
+// nil if none found.
+func (m *NatsTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NatsTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSubject()) < 1 {
+		err := NatsTargetValidationError{
+			field:  "Subject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NatsTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// NatsTargetMultiError is an error wrapping multiple validation errors
+// returned by NatsTarget.ValidateAll() if the designated constraints aren't met.
+type NatsTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NatsTargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NatsTargetMultiError) AllErrors() []error { return m }
+
+// NatsTargetValidationError is the validation error returned by
+// NatsTarget.Validate if the designated constraints aren't met.
+type NatsTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NatsTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NatsTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NatsTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NatsTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NatsTargetValidationError) ErrorName() string { return "NatsTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NatsTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNatsTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NatsTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NatsTargetValidationError{}
+
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetInitialDelay(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RetryPolicyValidationError{
+				field:  "InitialDelay",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := RetryPolicyValidationError{
+					field:  "InitialDelay",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetMultiplier() < 0 {
+		err := RetryPolicyValidationError{
+			field:  "Multiplier",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetMaxDelay(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RetryPolicyValidationError{
+				field:  "MaxDelay",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := RetryPolicyValidationError{
+					field:  "MaxDelay",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
 		}
-		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetConsumerName()) < 1 {
-		err := JetstreamBindingValidationError{
-			field:  "ConsumerName",
-			reason: "value length must be at least 1 runes",
+	if val := m.GetJitter(); val < 0 || val > 1 {
+		err := RetryPolicyValidationError{
+			field:  "Jitter",
+			reason: "value must be inside range [0, 1]",
 		}
 		if !all {
 			return err
@@ -1625,69 +4468,110 @@ func (m *JetstreamBinding) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetSubjectPattern()) < 1 {
-		err := JetstreamBindingValidationError{
-			field:  "SubjectPattern",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return RetryPolicyMultiError(errors)
 	}
 
-	// no validation rules for MaxBatchSize
+	return nil
+}
 
-	if all {
-		switch v := interface{}(m.GetMaxBatchLatency()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JetstreamBindingValidationError{
-					field:  "MaxBatchLatency",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JetstreamBindingValidationError{
-					field:  "MaxBatchLatency",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMaxBatchLatency()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JetstreamBindingValidationError{
-				field:  "MaxBatchLatency",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.GetAssignedPeer() != "" {
+// AllErrors returns a list of validation violation errors.
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
 
-		if err := m._validateUuid(m.GetAssignedPeer()); err != nil {
-			err = JetstreamBindingValidationError{
-				field:  "AssignedPeer",
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryPolicyValidationError) Key() bool { return e.key }
 
+// ErrorName returns error name.
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	switch v := m.DeliveryPolicy.(type) {
-	case *JetstreamBinding_Policy:
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryPolicyValidationError{}
+
+// Validate checks the field values on PartitionKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PartitionKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PartitionKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PartitionKeyMultiError, or
+// nil if none found.
+func (m *PartitionKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PartitionKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Key.(type) {
+	case *PartitionKey_SubjectToken:
 		if v == nil {
-			err := JetstreamBindingValidationError{
-				field:  "DeliveryPolicy",
+			err := PartitionKeyValidationError{
+				field:  "Key",
 				reason: "oneof value cannot be a typed-nil",
 			}
 			if !all {
@@ -1695,12 +4579,11 @@ func (m *JetstreamBinding) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
-		// no validation rules for Policy
-	case *JetstreamBinding_StartTime:
-		if v == nil {
-			err := JetstreamBindingValidationError{
-				field:  "DeliveryPolicy",
-				reason: "oneof value cannot be a typed-nil",
+
+		if m.GetSubjectToken() < 1 {
+			err := PartitionKeyValidationError{
+				field:  "SubjectToken",
+				reason: "value must be greater than or equal to 1",
 			}
 			if !all {
 				return err
@@ -1708,73 +4591,146 @@ func (m *JetstreamBinding) validate(all bool) error {
 			errors = append(errors, err)
 		}
 
-		if all {
-			switch v := interface{}(m.GetStartTime()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JetstreamBindingValidationError{
-						field:  "StartTime",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JetstreamBindingValidationError{
-						field:  "StartTime",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	case *PartitionKey_Header:
+		if v == nil {
+			err := PartitionKeyValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JetstreamBindingValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
 
-	case *JetstreamBinding_StartSequence:
-		if v == nil {
-			err := JetstreamBindingValidationError{
-				field:  "DeliveryPolicy",
-				reason: "oneof value cannot be a typed-nil",
+		if utf8.RuneCountInString(m.GetHeader()) < 1 {
+			err := PartitionKeyValidationError{
+				field:  "Header",
+				reason: "value length must be at least 1 runes",
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
 		}
-		// no validation rules for StartSequence
+
 	default:
 		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return JetstreamBindingMultiError(errors)
+		return PartitionKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *JetstreamBinding) _validateUuid(uuid string) error {
-	if matched := _v_1_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+// PartitionKeyMultiError is an error wrapping multiple validation errors
+// returned by PartitionKey.ValidateAll() if the designated constraints aren't met.
+type PartitionKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PartitionKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PartitionKeyMultiError) AllErrors() []error { return m }
+
+// PartitionKeyValidationError is the validation error returned by
+// PartitionKey.Validate if the designated constraints aren't met.
+type PartitionKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PartitionKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PartitionKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PartitionKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PartitionKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PartitionKeyValidationError) ErrorName() string { return "PartitionKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PartitionKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPartitionKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PartitionKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PartitionKeyValidationError{}
+
+// Validate checks the field values on Labels with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Labels) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Labels with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LabelsMultiError, or nil if none found.
+func (m *Labels) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Labels) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return LabelsMultiError(errors)
 	}
 
 	return nil
 }
 
-// JetstreamBindingMultiError is an error wrapping multiple validation errors
-// returned by JetstreamBinding.ValidateAll() if the designated constraints
-// aren't met.
-type JetstreamBindingMultiError []error
+// LabelsMultiError is an error wrapping multiple validation errors returned by
+// Labels.ValidateAll() if the designated constraints aren't met.
+type LabelsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JetstreamBindingMultiError) Error() string {
+func (m LabelsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1783,11 +4739,11 @@ func (m JetstreamBindingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JetstreamBindingMultiError) AllErrors() []error { return m }
+func (m LabelsMultiError) AllErrors() []error { return m }
 
-// JetstreamBindingValidationError is the validation error returned by
-// JetstreamBinding.Validate if the designated constraints aren't met.
-type JetstreamBindingValidationError struct {
+// LabelsValidationError is the validation error returned by Labels.Validate if
+// the designated constraints aren't met.
+type LabelsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1795,22 +4751,22 @@ type JetstreamBindingValidationError struct {
 }
 
 // Field function returns field value.
-func (e JetstreamBindingValidationError) Field() string { return e.field }
+func (e LabelsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JetstreamBindingValidationError) Reason() string { return e.reason }
+func (e LabelsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JetstreamBindingValidationError) Cause() error { return e.cause }
+func (e LabelsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JetstreamBindingValidationError) Key() bool { return e.key }
+func (e LabelsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JetstreamBindingValidationError) ErrorName() string { return "JetstreamBindingValidationError" }
+func (e LabelsValidationError) ErrorName() string { return "LabelsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JetstreamBindingValidationError) Error() string {
+func (e LabelsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1822,14 +4778,14 @@ func (e JetstreamBindingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJetstreamBinding.%s: %s%s",
+		"invalid %sLabels.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JetstreamBindingValidationError{}
+var _ error = LabelsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1837,4 +4793,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JetstreamBindingValidationError{}
+} = LabelsValidationError{}
@@ -25,13 +25,54 @@ const (
 	JetbridgeServiceName = "jetbridge.v1.JetbridgeService"
 )
 
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JetbridgeServiceListPeersProcedure is the fully-qualified name of the JetbridgeService's
+	// ListPeers RPC.
+	JetbridgeServiceListPeersProcedure = "/jetbridge.v1.JetbridgeService/ListPeers"
+	// JetbridgeServiceCreateBindingProcedure is the fully-qualified name of the JetbridgeService's
+	// CreateBinding RPC.
+	JetbridgeServiceCreateBindingProcedure = "/jetbridge.v1.JetbridgeService/CreateBinding"
+	// JetbridgeServiceGetBindingProcedure is the fully-qualified name of the JetbridgeService's
+	// GetBinding RPC.
+	JetbridgeServiceGetBindingProcedure = "/jetbridge.v1.JetbridgeService/GetBinding"
+	// JetbridgeServiceListBindingsProcedure is the fully-qualified name of the JetbridgeService's
+	// ListBindings RPC.
+	JetbridgeServiceListBindingsProcedure = "/jetbridge.v1.JetbridgeService/ListBindings"
+	// JetbridgeServiceUpdateBindingProcedure is the fully-qualified name of the JetbridgeService's
+	// UpdateBinding RPC.
+	JetbridgeServiceUpdateBindingProcedure = "/jetbridge.v1.JetbridgeService/UpdateBinding"
+	// JetbridgeServiceDeleteBindingProcedure is the fully-qualified name of the JetbridgeService's
+	// DeleteBinding RPC.
+	JetbridgeServiceDeleteBindingProcedure = "/jetbridge.v1.JetbridgeService/DeleteBinding"
+	// JetbridgeServiceGetBindingStatusProcedure is the fully-qualified name of the JetbridgeService's
+	// GetBindingStatus RPC.
+	JetbridgeServiceGetBindingStatusProcedure = "/jetbridge.v1.JetbridgeService/GetBindingStatus"
+	// JetbridgeServicePauseBindingProcedure is the fully-qualified name of the JetbridgeService's
+	// PauseBinding RPC.
+	JetbridgeServicePauseBindingProcedure = "/jetbridge.v1.JetbridgeService/PauseBinding"
+	// JetbridgeServiceResumeBindingProcedure is the fully-qualified name of the JetbridgeService's
+	// ResumeBinding RPC.
+	JetbridgeServiceResumeBindingProcedure = "/jetbridge.v1.JetbridgeService/ResumeBinding"
+)
+
 // JetbridgeServiceClient is a client for the jetbridge.v1.JetbridgeService service.
 type JetbridgeServiceClient interface {
 	ListPeers(context.Context, *connect_go.Request[v1.ListPeersRequest]) (*connect_go.Response[v1.ListPeersResponse], error)
 	CreateBinding(context.Context, *connect_go.Request[v1.CreateBindingRequest]) (*connect_go.Response[v1.CreateBindingResponse], error)
 	GetBinding(context.Context, *connect_go.Request[v1.GetBindingRequest]) (*connect_go.Response[v1.GetBindingResponse], error)
 	ListBindings(context.Context, *connect_go.Request[v1.ListBindingsRequest]) (*connect_go.Response[v1.ListBindingsResponse], error)
+	UpdateBinding(context.Context, *connect_go.Request[v1.UpdateBindingRequest]) (*connect_go.Response[v1.UpdateBindingResponse], error)
 	DeleteBinding(context.Context, *connect_go.Request[v1.DeleteBindingRequest]) (*connect_go.Response[v1.DeleteBindingResponse], error)
+	GetBindingStatus(context.Context, *connect_go.Request[v1.GetBindingStatusRequest]) (*connect_go.Response[v1.GetBindingStatusResponse], error)
+	PauseBinding(context.Context, *connect_go.Request[v1.PauseBindingRequest]) (*connect_go.Response[v1.PauseBindingResponse], error)
+	ResumeBinding(context.Context, *connect_go.Request[v1.ResumeBindingRequest]) (*connect_go.Response[v1.ResumeBindingResponse], error)
 }
 
 // NewJetbridgeServiceClient constructs a client for the jetbridge.v1.JetbridgeService service. By
@@ -46,27 +87,47 @@ func NewJetbridgeServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 	return &jetbridgeServiceClient{
 		listPeers: connect_go.NewClient[v1.ListPeersRequest, v1.ListPeersResponse](
 			httpClient,
-			baseURL+"/jetbridge.v1.JetbridgeService/ListPeers",
+			baseURL+JetbridgeServiceListPeersProcedure,
 			opts...,
 		),
 		createBinding: connect_go.NewClient[v1.CreateBindingRequest, v1.CreateBindingResponse](
 			httpClient,
-			baseURL+"/jetbridge.v1.JetbridgeService/CreateBinding",
+			baseURL+JetbridgeServiceCreateBindingProcedure,
 			opts...,
 		),
 		getBinding: connect_go.NewClient[v1.GetBindingRequest, v1.GetBindingResponse](
 			httpClient,
-			baseURL+"/jetbridge.v1.JetbridgeService/GetBinding",
+			baseURL+JetbridgeServiceGetBindingProcedure,
 			opts...,
 		),
 		listBindings: connect_go.NewClient[v1.ListBindingsRequest, v1.ListBindingsResponse](
 			httpClient,
-			baseURL+"/jetbridge.v1.JetbridgeService/ListBindings",
+			baseURL+JetbridgeServiceListBindingsProcedure,
+			opts...,
+		),
+		updateBinding: connect_go.NewClient[v1.UpdateBindingRequest, v1.UpdateBindingResponse](
+			httpClient,
+			baseURL+JetbridgeServiceUpdateBindingProcedure,
 			opts...,
 		),
 		deleteBinding: connect_go.NewClient[v1.DeleteBindingRequest, v1.DeleteBindingResponse](
 			httpClient,
-			baseURL+"/jetbridge.v1.JetbridgeService/DeleteBinding",
+			baseURL+JetbridgeServiceDeleteBindingProcedure,
+			opts...,
+		),
+		getBindingStatus: connect_go.NewClient[v1.GetBindingStatusRequest, v1.GetBindingStatusResponse](
+			httpClient,
+			baseURL+JetbridgeServiceGetBindingStatusProcedure,
+			opts...,
+		),
+		pauseBinding: connect_go.NewClient[v1.PauseBindingRequest, v1.PauseBindingResponse](
+			httpClient,
+			baseURL+JetbridgeServicePauseBindingProcedure,
+			opts...,
+		),
+		resumeBinding: connect_go.NewClient[v1.ResumeBindingRequest, v1.ResumeBindingResponse](
+			httpClient,
+			baseURL+JetbridgeServiceResumeBindingProcedure,
 			opts...,
 		),
 	}
@@ -74,11 +135,15 @@ func NewJetbridgeServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 
 // jetbridgeServiceClient implements JetbridgeServiceClient.
 type jetbridgeServiceClient struct {
-	listPeers     *connect_go.Client[v1.ListPeersRequest, v1.ListPeersResponse]
-	createBinding *connect_go.Client[v1.CreateBindingRequest, v1.CreateBindingResponse]
-	getBinding    *connect_go.Client[v1.GetBindingRequest, v1.GetBindingResponse]
-	listBindings  *connect_go.Client[v1.ListBindingsRequest, v1.ListBindingsResponse]
-	deleteBinding *connect_go.Client[v1.DeleteBindingRequest, v1.DeleteBindingResponse]
+	listPeers        *connect_go.Client[v1.ListPeersRequest, v1.ListPeersResponse]
+	createBinding    *connect_go.Client[v1.CreateBindingRequest, v1.CreateBindingResponse]
+	getBinding       *connect_go.Client[v1.GetBindingRequest, v1.GetBindingResponse]
+	listBindings     *connect_go.Client[v1.ListBindingsRequest, v1.ListBindingsResponse]
+	updateBinding    *connect_go.Client[v1.UpdateBindingRequest, v1.UpdateBindingResponse]
+	deleteBinding    *connect_go.Client[v1.DeleteBindingRequest, v1.DeleteBindingResponse]
+	getBindingStatus *connect_go.Client[v1.GetBindingStatusRequest, v1.GetBindingStatusResponse]
+	pauseBinding     *connect_go.Client[v1.PauseBindingRequest, v1.PauseBindingResponse]
+	resumeBinding    *connect_go.Client[v1.ResumeBindingRequest, v1.ResumeBindingResponse]
 }
 
 // ListPeers calls jetbridge.v1.JetbridgeService.ListPeers.
@@ -101,18 +166,42 @@ func (c *jetbridgeServiceClient) ListBindings(ctx context.Context, req *connect_
 	return c.listBindings.CallUnary(ctx, req)
 }
 
+// UpdateBinding calls jetbridge.v1.JetbridgeService.UpdateBinding.
+func (c *jetbridgeServiceClient) UpdateBinding(ctx context.Context, req *connect_go.Request[v1.UpdateBindingRequest]) (*connect_go.Response[v1.UpdateBindingResponse], error) {
+	return c.updateBinding.CallUnary(ctx, req)
+}
+
 // DeleteBinding calls jetbridge.v1.JetbridgeService.DeleteBinding.
 func (c *jetbridgeServiceClient) DeleteBinding(ctx context.Context, req *connect_go.Request[v1.DeleteBindingRequest]) (*connect_go.Response[v1.DeleteBindingResponse], error) {
 	return c.deleteBinding.CallUnary(ctx, req)
 }
 
+// GetBindingStatus calls jetbridge.v1.JetbridgeService.GetBindingStatus.
+func (c *jetbridgeServiceClient) GetBindingStatus(ctx context.Context, req *connect_go.Request[v1.GetBindingStatusRequest]) (*connect_go.Response[v1.GetBindingStatusResponse], error) {
+	return c.getBindingStatus.CallUnary(ctx, req)
+}
+
+// PauseBinding calls jetbridge.v1.JetbridgeService.PauseBinding.
+func (c *jetbridgeServiceClient) PauseBinding(ctx context.Context, req *connect_go.Request[v1.PauseBindingRequest]) (*connect_go.Response[v1.PauseBindingResponse], error) {
+	return c.pauseBinding.CallUnary(ctx, req)
+}
+
+// ResumeBinding calls jetbridge.v1.JetbridgeService.ResumeBinding.
+func (c *jetbridgeServiceClient) ResumeBinding(ctx context.Context, req *connect_go.Request[v1.ResumeBindingRequest]) (*connect_go.Response[v1.ResumeBindingResponse], error) {
+	return c.resumeBinding.CallUnary(ctx, req)
+}
+
 // JetbridgeServiceHandler is an implementation of the jetbridge.v1.JetbridgeService service.
 type JetbridgeServiceHandler interface {
 	ListPeers(context.Context, *connect_go.Request[v1.ListPeersRequest]) (*connect_go.Response[v1.ListPeersResponse], error)
 	CreateBinding(context.Context, *connect_go.Request[v1.CreateBindingRequest]) (*connect_go.Response[v1.CreateBindingResponse], error)
 	GetBinding(context.Context, *connect_go.Request[v1.GetBindingRequest]) (*connect_go.Response[v1.GetBindingResponse], error)
 	ListBindings(context.Context, *connect_go.Request[v1.ListBindingsRequest]) (*connect_go.Response[v1.ListBindingsResponse], error)
+	UpdateBinding(context.Context, *connect_go.Request[v1.UpdateBindingRequest]) (*connect_go.Response[v1.UpdateBindingResponse], error)
 	DeleteBinding(context.Context, *connect_go.Request[v1.DeleteBindingRequest]) (*connect_go.Response[v1.DeleteBindingResponse], error)
+	GetBindingStatus(context.Context, *connect_go.Request[v1.GetBindingStatusRequest]) (*connect_go.Response[v1.GetBindingStatusResponse], error)
+	PauseBinding(context.Context, *connect_go.Request[v1.PauseBindingRequest]) (*connect_go.Response[v1.PauseBindingResponse], error)
+	ResumeBinding(context.Context, *connect_go.Request[v1.ResumeBindingRequest]) (*connect_go.Response[v1.ResumeBindingResponse], error)
 }
 
 // NewJetbridgeServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -122,31 +211,51 @@ type JetbridgeServiceHandler interface {
 // and JSON codecs. They also support gzip compression.
 func NewJetbridgeServiceHandler(svc JetbridgeServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
 	mux := http.NewServeMux()
-	mux.Handle("/jetbridge.v1.JetbridgeService/ListPeers", connect_go.NewUnaryHandler(
-		"/jetbridge.v1.JetbridgeService/ListPeers",
+	mux.Handle(JetbridgeServiceListPeersProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceListPeersProcedure,
 		svc.ListPeers,
 		opts...,
 	))
-	mux.Handle("/jetbridge.v1.JetbridgeService/CreateBinding", connect_go.NewUnaryHandler(
-		"/jetbridge.v1.JetbridgeService/CreateBinding",
+	mux.Handle(JetbridgeServiceCreateBindingProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceCreateBindingProcedure,
 		svc.CreateBinding,
 		opts...,
 	))
-	mux.Handle("/jetbridge.v1.JetbridgeService/GetBinding", connect_go.NewUnaryHandler(
-		"/jetbridge.v1.JetbridgeService/GetBinding",
+	mux.Handle(JetbridgeServiceGetBindingProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceGetBindingProcedure,
 		svc.GetBinding,
 		opts...,
 	))
-	mux.Handle("/jetbridge.v1.JetbridgeService/ListBindings", connect_go.NewUnaryHandler(
-		"/jetbridge.v1.JetbridgeService/ListBindings",
+	mux.Handle(JetbridgeServiceListBindingsProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceListBindingsProcedure,
 		svc.ListBindings,
 		opts...,
 	))
-	mux.Handle("/jetbridge.v1.JetbridgeService/DeleteBinding", connect_go.NewUnaryHandler(
-		"/jetbridge.v1.JetbridgeService/DeleteBinding",
+	mux.Handle(JetbridgeServiceUpdateBindingProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceUpdateBindingProcedure,
+		svc.UpdateBinding,
+		opts...,
+	))
+	mux.Handle(JetbridgeServiceDeleteBindingProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceDeleteBindingProcedure,
 		svc.DeleteBinding,
 		opts...,
 	))
+	mux.Handle(JetbridgeServiceGetBindingStatusProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceGetBindingStatusProcedure,
+		svc.GetBindingStatus,
+		opts...,
+	))
+	mux.Handle(JetbridgeServicePauseBindingProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServicePauseBindingProcedure,
+		svc.PauseBinding,
+		opts...,
+	))
+	mux.Handle(JetbridgeServiceResumeBindingProcedure, connect_go.NewUnaryHandler(
+		JetbridgeServiceResumeBindingProcedure,
+		svc.ResumeBinding,
+		opts...,
+	))
 	return "/jetbridge.v1.JetbridgeService/", mux
 }
 
@@ -169,6 +278,22 @@ func (UnimplementedJetbridgeServiceHandler) ListBindings(context.Context, *conne
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("jetbridge.v1.JetbridgeService.ListBindings is not implemented"))
 }
 
+func (UnimplementedJetbridgeServiceHandler) UpdateBinding(context.Context, *connect_go.Request[v1.UpdateBindingRequest]) (*connect_go.Response[v1.UpdateBindingResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("jetbridge.v1.JetbridgeService.UpdateBinding is not implemented"))
+}
+
 func (UnimplementedJetbridgeServiceHandler) DeleteBinding(context.Context, *connect_go.Request[v1.DeleteBindingRequest]) (*connect_go.Response[v1.DeleteBindingResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("jetbridge.v1.JetbridgeService.DeleteBinding is not implemented"))
 }
+
+func (UnimplementedJetbridgeServiceHandler) GetBindingStatus(context.Context, *connect_go.Request[v1.GetBindingStatusRequest]) (*connect_go.Response[v1.GetBindingStatusResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("jetbridge.v1.JetbridgeService.GetBindingStatus is not implemented"))
+}
+
+func (UnimplementedJetbridgeServiceHandler) PauseBinding(context.Context, *connect_go.Request[v1.PauseBindingRequest]) (*connect_go.Response[v1.PauseBindingResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("jetbridge.v1.JetbridgeService.PauseBinding is not implemented"))
+}
+
+func (UnimplementedJetbridgeServiceHandler) ResumeBinding(context.Context, *connect_go.Request[v1.ResumeBindingRequest]) (*connect_go.Response[v1.ResumeBindingResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("jetbridge.v1.JetbridgeService.ResumeBinding is not implemented"))
+}
@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPeerWeight is the weight of peers that do not advertise one.
+const DefaultPeerWeight = 1
+
+// AssignPeer returns the peer a binding is assigned to, or nil if no peer has
+// the binding's required labels.
+//
+// Peers are chosen by weighted rendezvous hashing, so each is assigned a share
+// of the bindings proportional to its weight, and only the bindings of a peer
+// that joins or leaves are reassigned.
+func AssignPeer(binding JetstreamBinding, peers []Peer) *uuid.UUID {
+	var (
+		owner *uuid.UUID
+		best  float64
+	)
+
+	for i, peer := range peers {
+		if !peer.Labels.Matches(binding.RequiredLabels) {
+			continue
+		}
+
+		score := rendezvousScore(binding.ID, peer)
+		if owner == nil || score > best || (score == best && peer.ID.String() > owner.String()) {
+			owner, best = &peers[i].ID, score
+		}
+	}
+
+	if owner == nil {
+		return nil
+	}
+
+	id := *owner
+	return &id
+}
+
+// rendezvousScore is the logarithmic weighted rendezvous score of a peer for a
+// binding, -weight/ln(h) where h is the hash of the pair mapped onto (0, 1).
+func rendezvousScore(bindingID uuid.UUID, peer Peer) float64 {
+	weight := peer.Weight
+	if weight <= 0 {
+		weight = DefaultPeerWeight
+	}
+
+	h := sha256.New()
+	h.Write([]byte(peer.ID.String()))
+	h.Write([]byte(bindingID.String()))
+	sum := h.Sum(nil)
+
+	// Use the top 53 bits, offset by half a step so that h is never 0 or 1
+	u := (float64(binary.BigEndian.Uint64(sum)>>11) + 0.5) / (1 << 53)
+
+	return -weight / math.Log(u)
+}
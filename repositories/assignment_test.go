@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignPeer(t *testing.T) {
+	binding := JetstreamBinding{ID: uuid.New()}
+
+	assert.Nil(t, AssignPeer(binding, nil))
+
+	peers := []Peer{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}
+	owner := AssignPeer(binding, peers)
+	require.NotNil(t, owner)
+
+	// The owner does not depend on the order peers are listed in
+	reversed := []Peer{peers[2], peers[1], peers[0]}
+	assert.Equal(t, owner, AssignPeer(binding, reversed))
+}
+
+func TestAssignPeer_requiredLabels(t *testing.T) {
+	var (
+		eu = Peer{ID: uuid.New(), Labels: Labels{"region": "eu-west-1"}}
+		us = Peer{ID: uuid.New(), Labels: Labels{"region": "us-east-1", "vpc": "vpc-1234"}}
+	)
+
+	for i := 0; i < 100; i++ {
+		binding := JetstreamBinding{ID: uuid.New(), RequiredLabels: Labels{"region": "us-east-1"}}
+
+		owner := AssignPeer(binding, []Peer{eu, us})
+		require.NotNil(t, owner)
+		assert.Equal(t, us.ID, *owner)
+	}
+
+	binding := JetstreamBinding{ID: uuid.New(), RequiredLabels: Labels{"region": "ap-south-1"}}
+	assert.Nil(t, AssignPeer(binding, []Peer{eu, us}))
+}
+
+func TestAssignPeer_weighted(t *testing.T) {
+	var (
+		small = Peer{ID: uuid.New(), Weight: 1}
+		large = Peer{ID: uuid.New(), Weight: 3}
+	)
+
+	counts := map[uuid.UUID]int{}
+	for i := 0; i < 10000; i++ {
+		owner := AssignPeer(JetstreamBinding{ID: uuid.New()}, []Peer{small, large})
+		require.NotNil(t, owner)
+		counts[*owner]++
+	}
+
+	// The large peer should be assigned about three quarters of the bindings
+	assert.InDelta(t, 7500, counts[large.ID], 300)
+	assert.InDelta(t, 2500, counts[small.ID], 300)
+}
+
+func TestAssignPeer_defaultWeight(t *testing.T) {
+	binding := JetstreamBinding{ID: uuid.New()}
+
+	var (
+		unweighted = []Peer{{ID: uuid.New()}, {ID: uuid.New()}}
+		weighted   = []Peer{{ID: unweighted[0].ID, Weight: DefaultPeerWeight}, {ID: unweighted[1].ID, Weight: DefaultPeerWeight}}
+	)
+
+	assert.Equal(t, AssignPeer(binding, weighted), AssignPeer(binding, unweighted))
+}
@@ -2,15 +2,26 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 )
 
 //go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_bindings.go -package=mocks . Bindings
 
+// ErrBindingNotFound is returned when getting or updating a binding that does
+// not exist, or has been deleted.
+var ErrBindingNotFound = errors.New("binding not found")
+
+// ErrInvalidBinding is returned when updating a binding would leave its
+// settings inconsistent with each other, as checked by
+// JetstreamBinding.Validate.
+var ErrInvalidBinding = errors.New("invalid binding")
+
 type Bindings interface {
 	CreateJetstreamBinding(context.Context, *CreateJetstreamBinding) (*JetstreamBinding, error)
 	GetJetstreamBinding(ctx context.Context, id uuid.UUID) (*JetstreamBinding, error)
 	ListJetstreamBindings(ctx context.Context) ([]JetstreamBinding, error)
+	UpdateJetstreamBinding(ctx context.Context, id uuid.UUID, update *UpdateJetstreamBinding) (*JetstreamBinding, error)
 	DeleteJetstreamBinding(ctx context.Context, id uuid.UUID) error
 }
@@ -10,6 +10,24 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// testTarget is the target of the bindings created by the suite.
+var testTarget = repositories.Target{
+	Type:      repositories.TargetLambda,
+	URI:       "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+	Qualifier: "live",
+}
+
+// testWebhookTarget is the target bindings are updated to by the suite.
+var testWebhookTarget = repositories.Target{
+	Type: repositories.TargetWebhook,
+	URI:  "https://example.com/hooks/my-binding",
+	Webhook: repositories.WebhookConfig{
+		Headers:       map[string]string{"Authorization": "Bearer my-token"},
+		SigningSecret: "my-signing-secret",
+		Timeout:       5 * time.Second,
+	},
+}
+
 type BindingsConformanceSuite struct {
 	*suite.Suite
 
@@ -19,7 +37,10 @@ type BindingsConformanceSuite struct {
 }
 
 func (s *BindingsConformanceSuite) SetupSuite() {
-	p, err := s.Peers.JoinPeers(context.TODO())
+	p, err := s.Peers.JoinPeers(context.TODO(), &repositories.JoinPeer{
+		Weight: 1,
+		Labels: repositories.Labels{"region": "eu-west-1"},
+	})
 	s.Require().NoError(err)
 
 	s.peerID = p.ID
@@ -32,58 +53,103 @@ func (s *BindingsConformanceSuite) SetupTest() {
 
 func (s *BindingsConformanceSuite) TestCreateJetstreamBinding() {
 	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
-		LambdaARN:      "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+		Target:         testTarget,
 		Stream:         "my-stream",
 		Subject:        "my-subject",
 		MaxMessages:    0,
 		MaxLatency:     0,
-		DeliveryPolicy: "all",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
 	})
 	s.Require().NoError(err)
 	s.Require().NotNil(jb)
 
 	s.Assert().NotNil(jb.ID)
-	s.Assert().Equal("arn:aws:lambda:us-east-1:123456789012:function:my-function", jb.LambdaARN)
+	s.Assert().Equal(testTarget, jb.Target)
 	s.Assert().Equal("my-stream", jb.Stream)
 	s.Assert().Equal(jb.ID, jb.Consumer)
 	s.Assert().Equal("my-subject", jb.Subject)
 	s.Assert().Equal(0, jb.MaxMessages)
 	s.Assert().Equal(time.Duration(0), jb.MaxLatency)
-	s.Assert().Equal("all", jb.DeliveryPolicy)
+	s.Assert().Equal(repositories.DeliveryPolicy{Deliver: repositories.DeliverAll}, jb.DeliveryPolicy)
 	s.Assert().Equal(s.peerID, *jb.AssignedPeerID)
 }
 
 func (s *BindingsConformanceSuite) TestCreateJetstreamBinding_batched() {
 	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
-		LambdaARN:      "arn:aws:lambda:us-east-1:123456789012:function:my-function",
-		Stream:         "my-stream",
-		Subject:        "my-subject",
-		MaxMessages:    10,
-		MaxLatency:     5 * time.Second,
-		DeliveryPolicy: "all",
+		Target:            testTarget,
+		Stream:            "my-stream",
+		Subject:           "my-subject",
+		MaxMessages:       10,
+		MaxLatency:        5 * time.Second,
+		DeliveryPolicy:    repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
+		MaxDeliveries:     5,
+		DeadLetterSubject: "my-dead-letter-subject",
+		RetryPolicy: repositories.RetryPolicy{
+			InitialDelay: time.Second,
+			Multiplier:   2,
+			MaxDelay:     time.Minute,
+			Jitter:       0.1,
+		},
+		InvocationType: repositories.InvocationEvent,
+		PayloadFormat:  repositories.PayloadFormatCloudEvents,
+		MaxConcurrency: 4,
+		PartitionKey:   repositories.PartitionKey{SubjectToken: 2},
 	})
 	s.Require().NoError(err)
 	s.Require().NotNil(jb)
 
 	s.Assert().NotNil(jb.ID)
-	s.Assert().Equal("arn:aws:lambda:us-east-1:123456789012:function:my-function", jb.LambdaARN)
+	s.Assert().Equal(testTarget, jb.Target)
 	s.Assert().Equal("my-stream", jb.Stream)
 	s.Assert().Equal(jb.ID, jb.Consumer)
 	s.Assert().Equal("my-subject", jb.Subject)
 	s.Assert().Equal(10, jb.MaxMessages)
 	s.Assert().Equal(5*time.Second, jb.MaxLatency)
-	s.Assert().Equal("all", jb.DeliveryPolicy)
+	s.Assert().Equal(repositories.DeliveryPolicy{Deliver: repositories.DeliverAll}, jb.DeliveryPolicy)
 	s.Assert().Equal(s.peerID, *jb.AssignedPeerID)
+	s.Assert().Equal(5, jb.MaxDeliveries)
+	s.Assert().Equal("my-dead-letter-subject", jb.DeadLetterSubject)
+	s.Assert().Equal(repositories.RetryPolicy{InitialDelay: time.Second, Multiplier: 2, MaxDelay: time.Minute, Jitter: 0.1}, jb.RetryPolicy)
+	s.Assert().Equal(repositories.InvocationEvent, jb.InvocationType)
+	s.Assert().Equal(repositories.PayloadFormatCloudEvents, jb.PayloadFormat)
+	s.Assert().Equal(4, jb.MaxConcurrency)
+	s.Assert().Equal(repositories.PartitionKey{SubjectToken: 2}, jb.PartitionKey)
+}
+
+func (s *BindingsConformanceSuite) TestCreateJetstreamBinding_deliveryPolicy() {
+	policies := []repositories.DeliveryPolicy{
+		{Deliver: repositories.DeliverNew},
+		{Deliver: repositories.DeliverLastPerSubject},
+		{Deliver: repositories.DeliverByStartTime, StartTime: time.Date(2023, 6, 1, 12, 30, 0, 500, time.UTC)},
+		{Deliver: repositories.DeliverByStartSequence, StartSequence: 42},
+	}
+
+	for _, policy := range policies {
+		jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
+			Target:         testTarget,
+			Stream:         "my-stream",
+			Subject:        "my-subject",
+			DeliveryPolicy: policy,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(jb)
+		s.Assert().Equal(policy, jb.DeliveryPolicy)
+
+		got, err := s.Candidate.GetJetstreamBinding(context.TODO(), jb.ID)
+		s.Require().NoError(err)
+		s.Require().NotNil(got)
+		s.Assert().Equal(policy, got.DeliveryPolicy)
+	}
 }
 
 func (s *BindingsConformanceSuite) TestGetJetstreamBinding() {
 	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
-		LambdaARN:      "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+		Target:         testTarget,
 		Stream:         "my-stream",
 		Subject:        "my-subject",
 		MaxMessages:    10,
 		MaxLatency:     5 * time.Second,
-		DeliveryPolicy: "all",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
 	})
 	s.Require().NoError(err)
 	s.Require().NotNil(jb)
@@ -93,7 +159,7 @@ func (s *BindingsConformanceSuite) TestGetJetstreamBinding() {
 	s.Require().NotNil(got)
 
 	s.Assert().Equal(jb.ID, got.ID)
-	s.Assert().Equal(jb.LambdaARN, got.LambdaARN)
+	s.Assert().Equal(jb.Target, got.Target)
 	s.Assert().Equal(jb.Stream, got.Stream)
 	s.Assert().Equal(jb.ID, got.Consumer)
 	s.Assert().Equal(jb.Subject, got.Subject)
@@ -101,22 +167,27 @@ func (s *BindingsConformanceSuite) TestGetJetstreamBinding() {
 	s.Assert().Equal(jb.MaxLatency, got.MaxLatency)
 	s.Assert().Equal(jb.DeliveryPolicy, got.DeliveryPolicy)
 	s.Assert().Equal(jb.AssignedPeerID, got.AssignedPeerID)
+	s.Assert().Equal(jb.MaxDeliveries, got.MaxDeliveries)
+	s.Assert().Equal(jb.DeadLetterSubject, got.DeadLetterSubject)
+	s.Assert().Equal(jb.RetryPolicy, got.RetryPolicy)
+	s.Assert().Equal(jb.InvocationType, got.InvocationType)
+	s.Assert().Equal(jb.PayloadFormat, got.PayloadFormat)
 }
 
 func (s *BindingsConformanceSuite) TestGetJetstreamBinding_notFound() {
 	got, err := s.Candidate.GetJetstreamBinding(context.TODO(), uuid.New())
-	s.Require().Error(err)
+	s.Require().ErrorIs(err, repositories.ErrBindingNotFound)
 	s.Require().Nil(got)
 }
 
 func (s *BindingsConformanceSuite) TestListJetstreamBindings() {
 	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
-		LambdaARN:      "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+		Target:         testTarget,
 		Stream:         "my-stream",
 		Subject:        "my-subject",
 		MaxMessages:    10,
 		MaxLatency:     5 * time.Second,
-		DeliveryPolicy: "all",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
 	})
 	s.Require().NoError(err)
 	s.Require().NotNil(jb)
@@ -132,7 +203,7 @@ func (s *BindingsConformanceSuite) TestListJetstreamBindings() {
 	for _, elem := range list {
 		if elem.ID == jb.ID {
 			s.Assert().Equal(jb.ID, elem.ID)
-			s.Assert().Equal(jb.LambdaARN, elem.LambdaARN)
+			s.Assert().Equal(jb.Target, elem.Target)
 			s.Assert().Equal(jb.Stream, elem.Stream)
 			s.Assert().Equal(jb.ID, elem.Consumer)
 			s.Assert().Equal(jb.Subject, elem.Subject)
@@ -144,14 +215,197 @@ func (s *BindingsConformanceSuite) TestListJetstreamBindings() {
 	}
 }
 
+func (s *BindingsConformanceSuite) TestUpdateJetstreamBinding() {
+	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
+		Target:         testTarget,
+		Stream:         "my-stream",
+		Subject:        "my-subject",
+		MaxMessages:    10,
+		MaxLatency:     5 * time.Second,
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(jb)
+
+	var (
+		target            = testWebhookTarget
+		maxMessages       = 20
+		maxLatency        = 10 * time.Second
+		maxDeliveries     = 3
+		deadLetterSubject = "my-dead-letter-subject"
+		retryPolicy       = repositories.RetryPolicy{InitialDelay: 5 * time.Second, Multiplier: 1.5}
+		invocationType    = repositories.InvocationDryRun
+		payloadFormat     = repositories.PayloadFormatJSON
+		maxConcurrency    = 8
+		partitionKey      = repositories.PartitionKey{Header: "Customer-Id"}
+	)
+
+	updated, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), jb.ID, &repositories.UpdateJetstreamBinding{
+		Target:            &target,
+		MaxMessages:       &maxMessages,
+		MaxLatency:        &maxLatency,
+		MaxDeliveries:     &maxDeliveries,
+		DeadLetterSubject: &deadLetterSubject,
+		RetryPolicy:       &retryPolicy,
+		InvocationType:    &invocationType,
+		PayloadFormat:     &payloadFormat,
+		MaxConcurrency:    &maxConcurrency,
+		PartitionKey:      &partitionKey,
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(updated)
+
+	s.Assert().Equal(jb.ID, updated.ID)
+	s.Assert().Equal(target, updated.Target)
+	s.Assert().Equal(jb.Stream, updated.Stream)
+	s.Assert().Equal(jb.Consumer, updated.Consumer)
+	s.Assert().Equal(jb.Subject, updated.Subject)
+	s.Assert().Equal(maxMessages, updated.MaxMessages)
+	s.Assert().Equal(maxLatency, updated.MaxLatency)
+	s.Assert().Equal(jb.DeliveryPolicy, updated.DeliveryPolicy)
+	s.Assert().Equal(jb.AssignedPeerID, updated.AssignedPeerID)
+	s.Assert().Equal(maxDeliveries, updated.MaxDeliveries)
+	s.Assert().Equal(deadLetterSubject, updated.DeadLetterSubject)
+	s.Assert().Equal(retryPolicy, updated.RetryPolicy)
+	s.Assert().Equal(invocationType, updated.InvocationType)
+	s.Assert().Equal(payloadFormat, updated.PayloadFormat)
+	s.Assert().Equal(maxConcurrency, updated.MaxConcurrency)
+	s.Assert().Equal(partitionKey, updated.PartitionKey)
+
+	got, err := s.Candidate.GetJetstreamBinding(context.TODO(), jb.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+
+	s.Assert().Equal(updated.Target, got.Target)
+	s.Assert().Equal(updated.Consumer, got.Consumer)
+	s.Assert().Equal(updated.MaxMessages, got.MaxMessages)
+	s.Assert().Equal(updated.MaxLatency, got.MaxLatency)
+	s.Assert().Equal(updated.MaxDeliveries, got.MaxDeliveries)
+	s.Assert().Equal(updated.DeadLetterSubject, got.DeadLetterSubject)
+	s.Assert().Equal(updated.RetryPolicy, got.RetryPolicy)
+	s.Assert().Equal(updated.InvocationType, got.InvocationType)
+	s.Assert().Equal(updated.PayloadFormat, got.PayloadFormat)
+	s.Assert().Equal(updated.MaxConcurrency, got.MaxConcurrency)
+	s.Assert().Equal(updated.PartitionKey, got.PartitionKey)
+}
+
+func (s *BindingsConformanceSuite) TestUpdateJetstreamBinding_paused() {
+	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
+		Target:         testTarget,
+		Stream:         "my-stream",
+		Subject:        "my-subject",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(jb)
+	s.Assert().False(jb.Paused)
+
+	paused := true
+	updated, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), jb.ID, &repositories.UpdateJetstreamBinding{
+		Paused: &paused,
+	})
+	s.Require().NoError(err)
+	s.Assert().True(updated.Paused)
+	s.Assert().Equal(jb.Consumer, updated.Consumer)
+	s.Assert().Equal(jb.AssignedPeerID, updated.AssignedPeerID)
+
+	got, err := s.Candidate.GetJetstreamBinding(context.TODO(), jb.ID)
+	s.Require().NoError(err)
+	s.Assert().True(got.Paused)
+
+	paused = false
+	resumed, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), jb.ID, &repositories.UpdateJetstreamBinding{
+		Paused: &paused,
+	})
+	s.Require().NoError(err)
+	s.Assert().False(resumed.Paused)
+	s.Assert().Equal(jb.Target, resumed.Target)
+}
+
+func (s *BindingsConformanceSuite) TestUpdateJetstreamBinding_requiredLabels() {
+	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
+		Target:         testTarget,
+		Stream:         "my-stream",
+		Subject:        "my-subject",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
+		RequiredLabels: repositories.Labels{"region": "eu-west-1"},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(jb)
+	s.Assert().Equal(repositories.Labels{"region": "eu-west-1"}, jb.RequiredLabels)
+	s.Require().NotNil(jb.AssignedPeerID)
+	s.Assert().Equal(s.peerID, *jb.AssignedPeerID)
+
+	// No peer has the label, so the binding is left unassigned
+	labels := repositories.Labels{"region": "us-east-1"}
+	updated, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), jb.ID, &repositories.UpdateJetstreamBinding{
+		RequiredLabels: &labels,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(labels, updated.RequiredLabels)
+	s.Assert().Nil(updated.AssignedPeerID)
+
+	got, err := s.Candidate.GetJetstreamBinding(context.TODO(), jb.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(labels, got.RequiredLabels)
+	s.Assert().Nil(got.AssignedPeerID)
+
+	labels = repositories.Labels{}
+	cleared, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), jb.ID, &repositories.UpdateJetstreamBinding{
+		RequiredLabels: &labels,
+	})
+	s.Require().NoError(err)
+	s.Assert().Empty(cleared.RequiredLabels)
+	s.Require().NotNil(cleared.AssignedPeerID)
+	s.Assert().Equal(s.peerID, *cleared.AssignedPeerID)
+}
+
+func (s *BindingsConformanceSuite) TestUpdateJetstreamBinding_notFound() {
+	subject := "my-other-subject"
+
+	got, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), uuid.New(), &repositories.UpdateJetstreamBinding{
+		Subject: &subject,
+	})
+	s.Require().ErrorIs(err, repositories.ErrBindingNotFound)
+	s.Require().Nil(got)
+}
+
+func (s *BindingsConformanceSuite) TestUpdateJetstreamBinding_invalid() {
+	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
+		Target:         testTarget,
+		Stream:         "my-stream",
+		Subject:        "my-subject",
+		MaxMessages:    10,
+		MaxLatency:     5 * time.Second,
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
+		PartitionKey:   repositories.PartitionKey{SubjectToken: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(jb)
+
+	// Unbatching is only invalid because of the partition key the update
+	// leaves unchanged
+	maxMessages := 0
+	got, err := s.Candidate.UpdateJetstreamBinding(context.TODO(), jb.ID, &repositories.UpdateJetstreamBinding{
+		MaxMessages: &maxMessages,
+	})
+	s.Require().ErrorIs(err, repositories.ErrInvalidBinding)
+	s.Require().Nil(got)
+
+	got, err = s.Candidate.GetJetstreamBinding(context.TODO(), jb.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(10, got.MaxMessages)
+	s.Assert().Equal(jb.PartitionKey, got.PartitionKey)
+}
+
 func (s *BindingsConformanceSuite) TestDeleteJetstreamBinding() {
 	jb, err := s.Candidate.CreateJetstreamBinding(context.TODO(), &repositories.CreateJetstreamBinding{
-		LambdaARN:      "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+		Target:         testTarget,
 		Stream:         "my-stream",
 		Subject:        "my-subject",
 		MaxMessages:    10,
 		MaxLatency:     5 * time.Second,
-		DeliveryPolicy: "all",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
 	})
 	s.Require().NoError(err)
 	s.Require().NotNil(jb)
@@ -166,6 +420,10 @@ func (s *BindingsConformanceSuite) TestDeleteJetstreamBinding() {
 	s.Assert().False(slices.ContainsFunc(list, func(elem repositories.JetstreamBinding) bool {
 		return elem.ID == jb.ID
 	}))
+
+	got, err := s.Candidate.GetJetstreamBinding(context.TODO(), jb.ID)
+	s.Require().ErrorIs(err, repositories.ErrBindingNotFound)
+	s.Require().Nil(got)
 }
 
 func NewBindingsConformanceSuite(peers repositories.Peers, candidate repositories.Bindings) *BindingsConformanceSuite {
@@ -0,0 +1,179 @@
+package conformancetest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type LeasesConformanceSuite struct {
+	*suite.Suite
+
+	Candidate repositories.Leases
+}
+
+func (s *LeasesConformanceSuite) TestAcquireLease() {
+	var (
+		bindingID = uuid.New()
+		peerID    = uuid.New()
+	)
+
+	lease, err := s.Candidate.AcquireLease(context.TODO(), bindingID, peerID, time.Minute)
+	s.Require().NoError(err)
+	s.Require().NotNil(lease)
+
+	s.Assert().Equal(bindingID, lease.BindingID)
+	s.Assert().Equal(peerID, lease.PeerID)
+	s.Assert().Positive(lease.Token)
+	s.Assert().WithinDuration(time.Now().Add(time.Minute), lease.ExpiresAt, time.Second)
+}
+
+func (s *LeasesConformanceSuite) TestAcquireLease_held() {
+	bindingID := uuid.New()
+
+	_, err := s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), time.Minute)
+	s.Require().NoError(err)
+
+	lease, err := s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), time.Minute)
+	s.Assert().ErrorIs(err, repositories.ErrLeaseHeld)
+	s.Assert().Nil(lease)
+}
+
+func (s *LeasesConformanceSuite) TestAcquireLease_expired() {
+	var (
+		bindingID = uuid.New()
+		peerID    = uuid.New()
+	)
+
+	first, err := s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), 10*time.Millisecond)
+	s.Require().NoError(err)
+
+	time.Sleep(50 * time.Millisecond)
+
+	second, err := s.Candidate.AcquireLease(context.TODO(), bindingID, peerID, time.Minute)
+	s.Require().NoError(err)
+	s.Assert().Equal(peerID, second.PeerID)
+	s.Assert().Greater(second.Token, first.Token, "fencing tokens must increase with each holder")
+
+	// The previous holder must not be able to reclaim the lease
+	_, err = s.Candidate.RenewLease(context.TODO(), *first, time.Minute)
+	s.Assert().ErrorIs(err, repositories.ErrLeaseLost)
+
+	err = s.Candidate.ReleaseLease(context.TODO(), *first)
+	s.Assert().ErrorIs(err, repositories.ErrLeaseLost)
+}
+
+func (s *LeasesConformanceSuite) TestAcquireLease_racingPeers() {
+	const peers = 10
+
+	var (
+		bindingID = uuid.New()
+		start     = make(chan struct{})
+
+		mu       sync.Mutex
+		acquired []repositories.Lease
+		held     int
+		wg       sync.WaitGroup
+	)
+
+	for i := 0; i < peers; i++ {
+		wg.Add(1)
+		go func(peerID uuid.UUID) {
+			defer wg.Done()
+			<-start
+
+			lease, err := s.Candidate.AcquireLease(context.TODO(), bindingID, peerID, time.Minute)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				acquired = append(acquired, *lease)
+			case s.Assert().ErrorIs(err, repositories.ErrLeaseHeld):
+				held++
+			}
+		}(uuid.New())
+	}
+
+	close(start)
+	wg.Wait()
+
+	s.Assert().Len(acquired, 1, "exactly one racing peer must acquire the lease")
+	s.Assert().Equal(peers-1, held)
+}
+
+func (s *LeasesConformanceSuite) TestRenewLease() {
+	lease, err := s.Candidate.AcquireLease(context.TODO(), uuid.New(), uuid.New(), time.Second)
+	s.Require().NoError(err)
+
+	renewed, err := s.Candidate.RenewLease(context.TODO(), *lease, time.Minute)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(lease.BindingID, renewed.BindingID)
+	s.Assert().Equal(lease.PeerID, renewed.PeerID)
+	s.Assert().Equal(lease.Token, renewed.Token, "renewing must keep the fencing token")
+	s.Assert().True(renewed.ExpiresAt.After(lease.ExpiresAt))
+}
+
+func (s *LeasesConformanceSuite) TestRenewLease_racingPeers() {
+	bindingID := uuid.New()
+
+	lease, err := s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), 10*time.Millisecond)
+	s.Require().NoError(err)
+
+	time.Sleep(50 * time.Millisecond)
+
+	// The holder renews its expired lease as another peer acquires it, only
+	// one of them may succeed
+	var (
+		wg                   sync.WaitGroup
+		renewErr, acquireErr error
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, renewErr = s.Candidate.RenewLease(context.TODO(), *lease, time.Minute)
+	}()
+	go func() {
+		defer wg.Done()
+		_, acquireErr = s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), time.Minute)
+	}()
+	wg.Wait()
+
+	if renewErr == nil {
+		s.Assert().ErrorIs(acquireErr, repositories.ErrLeaseHeld)
+	} else {
+		s.Assert().ErrorIs(renewErr, repositories.ErrLeaseLost)
+		s.Assert().NoError(acquireErr)
+	}
+}
+
+func (s *LeasesConformanceSuite) TestReleaseLease() {
+	bindingID := uuid.New()
+
+	lease, err := s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), time.Minute)
+	s.Require().NoError(err)
+
+	err = s.Candidate.ReleaseLease(context.TODO(), *lease)
+	s.Require().NoError(err)
+
+	next, err := s.Candidate.AcquireLease(context.TODO(), bindingID, uuid.New(), time.Minute)
+	s.Require().NoError(err)
+	s.Assert().Greater(next.Token, lease.Token)
+
+	_, err = s.Candidate.RenewLease(context.TODO(), *lease, time.Minute)
+	s.Assert().ErrorIs(err, repositories.ErrLeaseLost)
+}
+
+func NewLeasesConformanceSuite(candidate repositories.Leases) *LeasesConformanceSuite {
+	return &LeasesConformanceSuite{
+		Suite:     &suite.Suite{},
+		Candidate: candidate,
+	}
+}
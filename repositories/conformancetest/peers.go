@@ -0,0 +1,70 @@
+package conformancetest
+
+import (
+	"context"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/slices"
+)
+
+type PeersConformanceSuite struct {
+	*suite.Suite
+
+	Candidate repositories.Peers
+}
+
+func (s *PeersConformanceSuite) TestJoinPeers() {
+	p, err := s.Candidate.JoinPeers(context.TODO(), &repositories.JoinPeer{
+		Weight: 2.5,
+		Labels: repositories.Labels{"region": "eu-west-1", "vpc": "vpc-1234"},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(p)
+
+	s.Assert().NotEmpty(p.Hostname)
+	s.Assert().Equal(2.5, p.Weight)
+	s.Assert().Equal(repositories.Labels{"region": "eu-west-1", "vpc": "vpc-1234"}, p.Labels)
+	s.Assert().True(p.HeartbeatDueBy.After(p.JoinedAt))
+
+	list, err := s.Candidate.ListPeers(context.TODO())
+	s.Require().NoError(err)
+
+	i := slices.IndexFunc(list, func(elem repositories.Peer) bool { return elem.ID == p.ID })
+	s.Require().GreaterOrEqual(i, 0)
+	s.Assert().Equal(p.Weight, list[i].Weight)
+	s.Assert().Equal(p.Labels, list[i].Labels)
+}
+
+func (s *PeersConformanceSuite) TestSendHeartbeat() {
+	p, err := s.Candidate.JoinPeers(context.TODO(), &repositories.JoinPeer{Weight: 1})
+	s.Require().NoError(err)
+
+	updated, err := s.Candidate.SendHeartbeat(context.TODO(), p.ID)
+	s.Require().NoError(err)
+	s.Assert().Equal(p.ID, updated.ID)
+	s.Assert().Equal(p.Weight, updated.Weight)
+	s.Assert().False(updated.HeartbeatDueBy.Before(p.HeartbeatDueBy))
+}
+
+func (s *PeersConformanceSuite) TestLeavePeers() {
+	p, err := s.Candidate.JoinPeers(context.TODO(), &repositories.JoinPeer{Weight: 1})
+	s.Require().NoError(err)
+
+	err = s.Candidate.LeavePeers(context.TODO(), p.ID)
+	s.Require().NoError(err)
+
+	list, err := s.Candidate.ListPeers(context.TODO())
+	s.Require().NoError(err)
+
+	s.Assert().False(slices.ContainsFunc(list, func(elem repositories.Peer) bool {
+		return elem.ID == p.ID
+	}))
+}
+
+func NewPeersConformanceSuite(candidate repositories.Peers) *PeersConformanceSuite {
+	return &PeersConformanceSuite{
+		Suite:     &suite.Suite{},
+		Candidate: candidate,
+	}
+}
@@ -0,0 +1,125 @@
+package conformancetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsConformanceSuite struct {
+	*suite.Suite
+
+	Candidate repositories.Stats
+}
+
+func (s *StatsConformanceSuite) TestRecordBindingStats() {
+	stats := &repositories.BindingStats{
+		BindingID:     uuid.New(),
+		PeerID:        uuid.New(),
+		RecordedAt:    time.Now().Truncate(time.Millisecond),
+		RunningUntil:  time.Now().Add(time.Minute).Truncate(time.Millisecond),
+		LastSuccessAt: time.Now().Add(-time.Second).Truncate(time.Millisecond),
+		LastErrorAt:   time.Now().Add(-time.Minute).Truncate(time.Millisecond),
+		LastError:     "function error",
+	}
+
+	err := s.Candidate.RecordBindingStats(context.TODO(), stats)
+	s.Require().NoError(err)
+
+	got, err := s.Candidate.GetBindingStats(context.TODO(), stats.BindingID)
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+
+	s.Assert().Equal(stats.BindingID, got.BindingID)
+	s.Assert().Equal(stats.PeerID, got.PeerID)
+	s.Assert().WithinDuration(stats.RecordedAt, got.RecordedAt, time.Millisecond)
+	s.Assert().WithinDuration(stats.RunningUntil, got.RunningUntil, time.Millisecond)
+	s.Assert().WithinDuration(stats.LastSuccessAt, got.LastSuccessAt, time.Millisecond)
+	s.Assert().WithinDuration(stats.LastErrorAt, got.LastErrorAt, time.Millisecond)
+	s.Assert().Equal("function error", got.LastError)
+}
+
+func (s *StatsConformanceSuite) TestRecordBindingStats_preservesResults() {
+	bindingID := uuid.New()
+
+	err := s.Candidate.RecordBindingStats(context.TODO(), &repositories.BindingStats{
+		BindingID:     bindingID,
+		PeerID:        uuid.New(),
+		RecordedAt:    time.Now(),
+		RunningUntil:  time.Now(),
+		LastSuccessAt: time.Now().Add(-time.Second),
+		LastErrorAt:   time.Now().Add(-time.Second),
+		LastError:     "function error",
+	})
+	s.Require().NoError(err)
+
+	// A new worker, which has yet to handle any messages
+	peerID := uuid.New()
+	err = s.Candidate.RecordBindingStats(context.TODO(), &repositories.BindingStats{
+		BindingID:    bindingID,
+		PeerID:       peerID,
+		RecordedAt:   time.Now(),
+		RunningUntil: time.Now().Add(time.Minute),
+	})
+	s.Require().NoError(err)
+
+	got, err := s.Candidate.GetBindingStats(context.TODO(), bindingID)
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+
+	s.Assert().Equal(peerID, got.PeerID)
+	s.Assert().True(got.Running(&peerID, time.Now()))
+	s.Assert().False(got.LastSuccessAt.IsZero())
+	s.Assert().False(got.LastErrorAt.IsZero())
+	s.Assert().Equal("function error", got.LastError)
+}
+
+func (s *StatsConformanceSuite) TestRecordBindingStats_staleLeaseToken() {
+	bindingID := uuid.New()
+	peerID := uuid.New()
+
+	err := s.Candidate.RecordBindingStats(context.TODO(), &repositories.BindingStats{
+		BindingID:    bindingID,
+		PeerID:       peerID,
+		LeaseToken:   2,
+		RecordedAt:   time.Now(),
+		RunningUntil: time.Now().Add(time.Minute),
+	})
+	s.Require().NoError(err)
+
+	// The previous holder of the lease, which has yet to notice losing it
+	err = s.Candidate.RecordBindingStats(context.TODO(), &repositories.BindingStats{
+		BindingID:    bindingID,
+		PeerID:       uuid.New(),
+		LeaseToken:   1,
+		RecordedAt:   time.Now(),
+		RunningUntil: time.Now().Add(time.Minute),
+		LastErrorAt:  time.Now(),
+		LastError:    "function error",
+	})
+	s.Require().ErrorIs(err, repositories.ErrLeaseLost)
+
+	got, err := s.Candidate.GetBindingStats(context.TODO(), bindingID)
+	s.Require().NoError(err)
+	s.Require().NotNil(got)
+
+	s.Assert().Equal(peerID, got.PeerID)
+	s.Assert().Equal(int64(2), got.LeaseToken)
+	s.Assert().Empty(got.LastError)
+}
+
+func (s *StatsConformanceSuite) TestGetBindingStats_notRecorded() {
+	got, err := s.Candidate.GetBindingStats(context.TODO(), uuid.New())
+	s.Require().NoError(err)
+	s.Assert().Nil(got)
+}
+
+func NewStatsConformanceSuite(candidate repositories.Stats) *StatsConformanceSuite {
+	return &StatsConformanceSuite{
+		Suite:     &suite.Suite{},
+		Candidate: candidate,
+	}
+}
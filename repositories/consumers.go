@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_consumers.go -package=mocks . Consumers
+
+// ErrConsumerNotFound is returned when a binding's consumer has not been
+// created, as no worker has run the binding yet.
+var ErrConsumerNotFound = errors.New("consumer not found")
+
+// Consumers inspects the JetStream consumers backing bindings.
+type Consumers interface {
+	GetConsumerStatus(ctx context.Context, binding JetstreamBinding) (*ConsumerStatus, error)
+
+	// ListConsumers returns every consumer created by this deployment of
+	// JetBridge, across all streams, whether or not its binding still exists.
+	// Consumers created by other deployments sharing the NATS account, or
+	// before consumers were tagged with their deployment, are not listed.
+	ListConsumers(ctx context.Context) ([]Consumer, error)
+	DeleteConsumer(ctx context.Context, consumer Consumer) error
+}
+
+// Consumer identifies a JetStream consumer created for a binding, which is
+// named after JetstreamBinding.Consumer.
+type Consumer struct {
+	Stream string
+	Name   uuid.UUID
+}
+
+type ConsumerStatus struct {
+	NumPending     uint64
+	NumAckPending  int
+	NumRedelivered int
+
+	LastDeliveredStreamSequence   uint64
+	LastDeliveredConsumerSequence uint64
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DeliverPolicy identifies where in a stream a binding's consumer begins
+// reading messages.
+type DeliverPolicy int
+
+const (
+	// DeliverAll starts from the earliest message available in the stream.
+	DeliverAll DeliverPolicy = iota
+	// DeliverLast starts from the most recent message in the stream.
+	DeliverLast
+	// DeliverLastPerSubject starts from the most recent message of each
+	// subject matching the binding's subject pattern.
+	DeliverLastPerSubject
+	// DeliverNew starts from messages published after the consumer is created.
+	DeliverNew
+	// DeliverByStartTime starts from the first message at or after StartTime.
+	DeliverByStartTime
+	// DeliverByStartSequence starts from the message with StartSequence.
+	DeliverByStartSequence
+)
+
+// DeliveryPolicy is the typed representation of where a binding starts
+// consuming from. StartTime and StartSequence are only meaningful for the
+// DeliverByStartTime and DeliverByStartSequence policies respectively.
+//
+// The zero value is DeliverAll.
+type DeliveryPolicy struct {
+	Deliver       DeliverPolicy
+	StartTime     time.Time
+	StartSequence uint64
+}
+
+// ParseDeliveryPolicy parses the string form of a delivery policy, as
+// produced by DeliveryPolicy.String. This is one of the special values 'all',
+// 'last', 'last-per-subject' or 'new', an RFC3339 timestamp or an integer
+// stream sequence number.
+func ParseDeliveryPolicy(s string) (DeliveryPolicy, error) {
+	switch s {
+	case "all":
+		return DeliveryPolicy{Deliver: DeliverAll}, nil
+	case "last":
+		return DeliveryPolicy{Deliver: DeliverLast}, nil
+	case "last-per-subject":
+		return DeliveryPolicy{Deliver: DeliverLastPerSubject}, nil
+	case "new":
+		return DeliveryPolicy{Deliver: DeliverNew}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return DeliveryPolicy{Deliver: DeliverByStartTime, StartTime: t}, nil
+	}
+
+	if i, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return DeliveryPolicy{Deliver: DeliverByStartSequence, StartSequence: i}, nil
+	}
+
+	return DeliveryPolicy{}, fmt.Errorf("invalid delivery policy: %q", s)
+}
+
+func (p DeliveryPolicy) String() string {
+	switch p.Deliver {
+	case DeliverAll:
+		return "all"
+	case DeliverLast:
+		return "last"
+	case DeliverLastPerSubject:
+		return "last-per-subject"
+	case DeliverNew:
+		return "new"
+	case DeliverByStartTime:
+		return p.StartTime.UTC().Format(time.RFC3339Nano)
+	case DeliverByStartSequence:
+		return strconv.FormatUint(p.StartSequence, 10)
+	default:
+		return fmt.Sprintf("DeliverPolicy(%d)", p.Deliver)
+	}
+}
+
+func (p DeliveryPolicy) MarshalText() ([]byte, error) {
+	if p.Deliver < DeliverAll || p.Deliver > DeliverByStartSequence {
+		return nil, fmt.Errorf("invalid delivery policy: %s", p)
+	}
+
+	return []byte(p.String()), nil
+}
+
+func (p *DeliveryPolicy) UnmarshalText(text []byte) error {
+	parsed, err := ParseDeliveryPolicy(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
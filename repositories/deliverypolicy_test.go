@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeliveryPolicy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected DeliveryPolicy
+	}{
+		{input: "all", expected: DeliveryPolicy{Deliver: DeliverAll}},
+		{input: "last", expected: DeliveryPolicy{Deliver: DeliverLast}},
+		{input: "last-per-subject", expected: DeliveryPolicy{Deliver: DeliverLastPerSubject}},
+		{input: "new", expected: DeliveryPolicy{Deliver: DeliverNew}},
+		{input: "2023-06-01T12:30:00Z", expected: DeliveryPolicy{Deliver: DeliverByStartTime, StartTime: time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)}},
+		{input: "42", expected: DeliveryPolicy{Deliver: DeliverByStartSequence, StartSequence: 42}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDeliveryPolicy(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, tt.input, got.String())
+		})
+	}
+}
+
+func TestParseDeliveryPolicy_invalid(t *testing.T) {
+	for _, input := range []string{"", "everything", "-1", "2023-06-01"} {
+		_, err := ParseDeliveryPolicy(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -2,6 +2,7 @@ package dynamo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +13,14 @@ import (
 
 var _ repositories.Bindings = (*Bindings)(nil)
 
+// maxUpdateAttempts bounds how many times an update is retried when the
+// binding is concurrently changed between it being read and written.
+const maxUpdateAttempts = 10
+
+// errUpdateConflict is returned by a conditional update when the record
+// changed since it was read.
+var errUpdateConflict = errors.New("concurrent update")
+
 type Bindings struct {
 	db        *dynamo.DB
 	tableName string
@@ -59,6 +68,10 @@ func (b *Bindings) GetJetstreamBinding(ctx context.Context, id uuid.UUID) (*repo
 
 	var binding jetstreamBindingRecord
 	if err := bindingQuery.OneWithContext(ctx, &binding); err != nil {
+		if errors.Is(err, dynamo.ErrNotFound) {
+			return nil, fmt.Errorf("jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+		}
+
 		return nil, err
 	}
 
@@ -87,6 +100,148 @@ func (b *Bindings) ListJetstreamBindings(ctx context.Context) ([]repositories.Je
 	return bindings.toJetstreamBindings(peers), nil
 }
 
+func (b *Bindings) UpdateJetstreamBinding(ctx context.Context, id uuid.UUID, update *repositories.UpdateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		binding, err := b.updateJetstreamBinding(ctx, id, update)
+		if errors.Is(err, errUpdateConflict) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		peerQuery := b.db.Table(b.tableName).
+			Get("pk", &peerPK{}).
+			Filter("delete_after > ?", time.Now())
+
+		var peers []peerRecord
+		if err := peerQuery.AllWithContext(ctx, &peers); err != nil {
+			return nil, err
+		}
+
+		return binding.toJetstreamBinding(peers), nil
+	}
+
+	return nil, fmt.Errorf("failed to update jetstream binding %s: too many concurrent writes", id)
+}
+
+// updateJetstreamBinding makes a single attempt at an update, returning
+// errUpdateConflict if the binding was changed since it was validated.
+func (b *Bindings) updateJetstreamBinding(ctx context.Context, id uuid.UUID, update *repositories.UpdateJetstreamBinding) (*jetstreamBindingRecord, error) {
+	bindingQuery := b.db.Table(b.tableName).
+		Get("pk", &jetstreamBindingPK{}).
+		Range("sk", dynamo.Equal, id.String()).
+		Consistent(true)
+
+	var current jetstreamBindingRecord
+	if err := bindingQuery.OneWithContext(ctx, &current); err != nil {
+		if errors.Is(err, dynamo.ErrNotFound) {
+			return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to update jetstream binding: %w", err)
+	}
+
+	if err := current.toJetstreamBinding(nil).ValidateUpdate(update); err != nil {
+		return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, err)
+	}
+
+	// The condition fails if the binding was updated or deleted since it was
+	// read, in which case it is read and validated again
+	updateQuery := b.db.Table(b.tableName).
+		Update("pk", &jetstreamBindingPK{}).
+		Range("sk", id).
+		Set("updated_at", time.Now()).
+		If("updated_at = ?", current.UpdatedAt)
+
+	if update.Target != nil {
+		updateQuery.
+			Set("target_type", update.Target.Type).
+			Set("target_uri", update.Target.URI).
+			Set("target_qualifier", update.Target.Qualifier).
+			Set("webhook_signing_secret", update.Target.Webhook.SigningSecret).
+			Set("webhook_timeout", update.Target.Webhook.Timeout).
+			Set("webhook_insecure", update.Target.Webhook.Insecure).
+			Remove("lambda_arn")
+
+		if len(update.Target.Webhook.Headers) == 0 {
+			updateQuery.Remove("webhook_headers")
+		} else {
+			updateQuery.Set("webhook_headers", update.Target.Webhook.Headers)
+		}
+	}
+
+	if update.Subject != nil {
+		updateQuery.Set("nats_subject_pattern", *update.Subject)
+	}
+
+	if update.MaxMessages != nil {
+		updateQuery.Set("max_messages", *update.MaxMessages)
+	}
+
+	if update.MaxLatency != nil {
+		updateQuery.Set("max_latency", *update.MaxLatency)
+	}
+
+	if update.MaxDeliveries != nil {
+		updateQuery.Set("max_deliveries", *update.MaxDeliveries)
+	}
+
+	if update.DeadLetterSubject != nil {
+		updateQuery.Set("dead_letter_subject", *update.DeadLetterSubject)
+	}
+
+	if update.RetryPolicy != nil {
+		updateQuery.
+			Set("retry_initial_delay", update.RetryPolicy.InitialDelay).
+			Set("retry_multiplier", update.RetryPolicy.Multiplier).
+			Set("retry_max_delay", update.RetryPolicy.MaxDelay).
+			Set("retry_jitter", update.RetryPolicy.Jitter)
+	}
+
+	if update.InvocationType != nil {
+		updateQuery.Set("invocation_type", *update.InvocationType)
+	}
+
+	if update.PayloadFormat != nil {
+		updateQuery.Set("payload_format", *update.PayloadFormat)
+	}
+
+	if update.MaxConcurrency != nil {
+		updateQuery.Set("max_concurrency", *update.MaxConcurrency)
+	}
+
+	if update.PartitionKey != nil {
+		updateQuery.
+			Set("partition_subject_token", update.PartitionKey.SubjectToken).
+			Set("partition_header", update.PartitionKey.Header)
+	}
+
+	if update.Paused != nil {
+		updateQuery.Set("paused", *update.Paused)
+	}
+
+	if update.RequiredLabels != nil {
+		if len(*update.RequiredLabels) == 0 {
+			updateQuery.Remove("required_labels")
+		} else {
+			updateQuery.Set("required_labels", *update.RequiredLabels)
+		}
+	}
+
+	var binding jetstreamBindingRecord
+	if err := updateQuery.ValueWithContext(ctx, &binding); err != nil {
+		if dynamo.IsCondCheckFailed(err) {
+			return nil, errUpdateConflict
+		}
+
+		return nil, fmt.Errorf("failed to update jetstream binding: %w", err)
+	}
+
+	return &binding, nil
+}
+
 func (b *Bindings) DeleteJetstreamBinding(ctx context.Context, id uuid.UUID) error {
 	query := b.db.Table(b.tableName).
 		Delete("pk", &jetstreamBindingPK{}).
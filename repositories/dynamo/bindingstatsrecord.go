@@ -0,0 +1,53 @@
+package dynamo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/uuid"
+)
+
+type bindingStatsRecord struct {
+	PK            *bindingStatsPK `dynamo:"pk,hash"`
+	BindingID     uuid.UUID       `dynamo:"sk,range"`
+	PeerID        uuid.UUID       `dynamo:"peer_id"`
+	LeaseToken    int64           `dynamo:"lease_token"`
+	RunningUntil  time.Time       `dynamo:"running_until"`
+	LastSuccessAt time.Time       `dynamo:"last_success_at"`
+	LastErrorAt   time.Time       `dynamo:"last_error_at"`
+	LastError     string          `dynamo:"last_error"`
+	UpdatedAt     time.Time       `dynamo:"updated_at" localIndex:"updated_at-index"`
+	DeleteAfter   time.Time       `dynamo:"delete_after,unixtime"`
+}
+
+func (r *bindingStatsRecord) toBindingStats() *repositories.BindingStats {
+	return &repositories.BindingStats{
+		BindingID:     r.BindingID,
+		PeerID:        r.PeerID,
+		LeaseToken:    r.LeaseToken,
+		RecordedAt:    r.UpdatedAt,
+		RunningUntil:  r.RunningUntil,
+		LastSuccessAt: r.LastSuccessAt,
+		LastErrorAt:   r.LastErrorAt,
+		LastError:     r.LastError,
+	}
+}
+
+type bindingStatsPK struct{}
+
+func (*bindingStatsPK) MarshalDynamo() (*dynamodb.AttributeValue, error) {
+	return &dynamodb.AttributeValue{
+		S: aws.String("BINDING_STATS"),
+	}, nil
+}
+
+func (*bindingStatsPK) UnmarshalDynamo(av *dynamodb.AttributeValue) error {
+	if av == nil || av.S == nil || *av.S != "BINDING_STATS" {
+		return fmt.Errorf("invalid bindingStatsPK: %v", av)
+	}
+
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/JoeReid/go-rendezvous"
 	"github.com/JoeReid/jetbridge/repositories"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
@@ -21,55 +20,117 @@ func (r jetstreamBindingRecords) toJetstreamBindings(peers []peerRecord) []repos
 	return bindings
 }
 
-type jetstreamBindingRecord struct {
-	PK             *jetstreamBindingPK `dynamo:"pk,hash"`
-	ID             uuid.UUID           `dynamo:"sk,range"`
-	LambdaARN      string              `dynamo:"lambda_arn"`
-	Stream         string              `dynamo:"nats_stream"`
-	Consumer       uuid.UUID           `dynamo:"nats_consumer"`
-	SubjectPattern string              `dynamo:"nats_subject_pattern"`
-	MaxMessages    int                 `dynamo:"max_messages"`
-	MaxLatency     time.Duration       `dynamo:"max_latency"`
-	DeliveryPolicy string              `dynamo:"delivery_policy"`
-	CreatedAt      time.Time           `dynamo:"created_at" localIndex:"created_at-index"`
-	UpdatedAt      time.Time           `dynamo:"updated_at" localIndex:"updated_at-index"`
-}
-
-func (r *jetstreamBindingRecord) assignedPeerID(peerIDs ...uuid.UUID) *uuid.UUID {
-	if len(peerIDs) == 0 {
-		return nil
-	}
+// deliveryPolicy is the delivery policy of a binding record. Records written
+// before delivery policies were implemented may hold any string, as it was
+// stored but never used, and their consumers were all created to deliver all
+// messages. Such records are read as DeliverAll rather than failing to read,
+// which would fail to list every binding.
+type deliveryPolicy repositories.DeliveryPolicy
 
-	peers := make([]string, len(peerIDs))
-	for i, peerID := range peerIDs {
-		peers[i] = peerID.String()
-	}
+func (p deliveryPolicy) MarshalText() ([]byte, error) {
+	return repositories.DeliveryPolicy(p).MarshalText()
+}
 
-	h := rendezvous.NewHasher(rendezvous.WithMembers(peers...))
-	owner, err := uuid.Parse(h.Owner(r.ID.String()))
+func (p *deliveryPolicy) UnmarshalText(text []byte) error {
+	parsed, err := repositories.ParseDeliveryPolicy(string(text))
 	if err != nil {
-		panic(fmt.Sprintf("failed to parse owner: %s", err))
+		parsed = repositories.DeliveryPolicy{Deliver: repositories.DeliverAll}
 	}
 
-	return &owner
+	*p = deliveryPolicy(parsed)
+	return nil
 }
 
-func (r *jetstreamBindingRecord) toJetstreamBinding(peers []peerRecord) *repositories.JetstreamBinding {
-	peerIDs := make([]uuid.UUID, len(peers))
-	for i, peer := range peers {
-		peerIDs[i] = peer.ID
-	}
+type jetstreamBindingRecord struct {
+	PK                    *jetstreamBindingPK         `dynamo:"pk,hash"`
+	ID                    uuid.UUID                   `dynamo:"sk,range"`
+	TargetType            repositories.TargetType     `dynamo:"target_type"`
+	TargetURI             string                      `dynamo:"target_uri"`
+	TargetQualifier       string                      `dynamo:"target_qualifier"`
+	WebhookHeaders        map[string]string           `dynamo:"webhook_headers"`
+	WebhookSigningSecret  string                      `dynamo:"webhook_signing_secret"`
+	WebhookTimeout        time.Duration               `dynamo:"webhook_timeout"`
+	WebhookInsecure       bool                        `dynamo:"webhook_insecure"`
+	LambdaARN             string                      `dynamo:"lambda_arn,omitempty"`
+	Stream                string                      `dynamo:"nats_stream"`
+	Consumer              uuid.UUID                   `dynamo:"nats_consumer"`
+	SubjectPattern        string                      `dynamo:"nats_subject_pattern"`
+	MaxMessages           int                         `dynamo:"max_messages"`
+	MaxLatency            time.Duration               `dynamo:"max_latency"`
+	DeliveryPolicy        deliveryPolicy              `dynamo:"delivery_policy"`
+	MaxDeliveries         int                         `dynamo:"max_deliveries"`
+	DeadLetterSubject     string                      `dynamo:"dead_letter_subject"`
+	RetryInitialDelay     time.Duration               `dynamo:"retry_initial_delay"`
+	RetryMultiplier       float64                     `dynamo:"retry_multiplier"`
+	RetryMaxDelay         time.Duration               `dynamo:"retry_max_delay"`
+	RetryJitter           float64                     `dynamo:"retry_jitter"`
+	InvocationType        repositories.InvocationType `dynamo:"invocation_type"`
+	PayloadFormat         repositories.PayloadFormat  `dynamo:"payload_format"`
+	MaxConcurrency        int                         `dynamo:"max_concurrency"`
+	PartitionSubjectToken int                         `dynamo:"partition_subject_token"`
+	PartitionHeader       string                      `dynamo:"partition_header"`
+	Paused                bool                        `dynamo:"paused"`
+	RequiredLabels        repositories.Labels         `dynamo:"required_labels"`
+	CreatedAt             time.Time                   `dynamo:"created_at" localIndex:"created_at-index"`
+	UpdatedAt             time.Time                   `dynamo:"updated_at" localIndex:"updated_at-index"`
+}
 
-	return &repositories.JetstreamBinding{
+func (r *jetstreamBindingRecord) toJetstreamBinding(peers []peerRecord) *repositories.JetstreamBinding {
+	binding := &repositories.JetstreamBinding{
 		ID:             r.ID,
-		LambdaARN:      r.LambdaARN,
+		Target:         r.target(),
 		Stream:         r.Stream,
 		Consumer:       r.Consumer,
 		Subject:        r.SubjectPattern,
 		MaxMessages:    r.MaxMessages,
 		MaxLatency:     r.MaxLatency,
-		DeliveryPolicy: r.DeliveryPolicy,
-		AssignedPeerID: r.assignedPeerID(peerIDs...),
+		DeliveryPolicy: repositories.DeliveryPolicy(r.DeliveryPolicy),
+
+		MaxDeliveries:     r.MaxDeliveries,
+		DeadLetterSubject: r.DeadLetterSubject,
+		RetryPolicy: repositories.RetryPolicy{
+			InitialDelay: r.RetryInitialDelay,
+			Multiplier:   r.RetryMultiplier,
+			MaxDelay:     r.RetryMaxDelay,
+			Jitter:       r.RetryJitter,
+		},
+		InvocationType: r.InvocationType,
+		PayloadFormat:  r.PayloadFormat,
+		MaxConcurrency: r.MaxConcurrency,
+		PartitionKey: repositories.PartitionKey{
+			SubjectToken: r.PartitionSubjectToken,
+			Header:       r.PartitionHeader,
+		},
+		Paused:         r.Paused,
+		RequiredLabels: r.RequiredLabels,
+	}
+
+	assignable := make([]repositories.Peer, len(peers))
+	for i, peer := range peers {
+		assignable[i] = *peer.toPeer()
+	}
+	binding.AssignedPeerID = repositories.AssignPeer(*binding, assignable)
+
+	return binding
+}
+
+// target returns the target of the binding, which records written before
+// bindings had target types give as just the ARN of a Lambda.
+func (r *jetstreamBindingRecord) target() repositories.Target {
+	if r.TargetURI == "" && r.LambdaARN != "" {
+		return repositories.Target{Type: repositories.TargetLambda, URI: r.LambdaARN}
+	}
+
+	return repositories.Target{
+		Type:      r.TargetType,
+		URI:       r.TargetURI,
+		Qualifier: r.TargetQualifier,
+		Webhook: repositories.WebhookConfig{
+			Headers:       r.WebhookHeaders,
+			SigningSecret: r.WebhookSigningSecret,
+			Timeout:       r.WebhookTimeout,
+			Insecure:      r.WebhookInsecure,
+		},
 	}
 }
 
@@ -77,17 +138,35 @@ func newJetstreamBinding(create *repositories.CreateJetstreamBinding) (*jetstrea
 	id := uuid.New()
 
 	return &jetstreamBindingRecord{
-		PK:             &jetstreamBindingPK{},
-		ID:             id,
-		LambdaARN:      create.LambdaARN,
-		Stream:         create.Stream,
-		Consumer:       id,
-		SubjectPattern: create.Subject,
-		MaxMessages:    create.MaxMessages,
-		MaxLatency:     create.MaxLatency,
-		DeliveryPolicy: create.DeliveryPolicy,
-		CreatedAt:      time.Now(),
-		UpdatedAt:      time.Now(),
+		PK:                    &jetstreamBindingPK{},
+		ID:                    id,
+		TargetType:            create.Target.Type,
+		TargetURI:             create.Target.URI,
+		TargetQualifier:       create.Target.Qualifier,
+		WebhookHeaders:        create.Target.Webhook.Headers,
+		WebhookSigningSecret:  create.Target.Webhook.SigningSecret,
+		WebhookTimeout:        create.Target.Webhook.Timeout,
+		WebhookInsecure:       create.Target.Webhook.Insecure,
+		Stream:                create.Stream,
+		Consumer:              id,
+		SubjectPattern:        create.Subject,
+		MaxMessages:           create.MaxMessages,
+		MaxLatency:            create.MaxLatency,
+		DeliveryPolicy:        deliveryPolicy(create.DeliveryPolicy),
+		MaxDeliveries:         create.MaxDeliveries,
+		DeadLetterSubject:     create.DeadLetterSubject,
+		RetryInitialDelay:     create.RetryPolicy.InitialDelay,
+		RetryMultiplier:       create.RetryPolicy.Multiplier,
+		RetryMaxDelay:         create.RetryPolicy.MaxDelay,
+		RetryJitter:           create.RetryPolicy.Jitter,
+		InvocationType:        create.InvocationType,
+		PayloadFormat:         create.PayloadFormat,
+		MaxConcurrency:        create.MaxConcurrency,
+		PartitionSubjectToken: create.PartitionKey.SubjectToken,
+		PartitionHeader:       create.PartitionKey.Header,
+		RequiredLabels:        create.RequiredLabels,
+		CreatedAt:             time.Now(),
+		UpdatedAt:             time.Now(),
 	}, nil
 }
 
@@ -0,0 +1,47 @@
+package dynamo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/uuid"
+)
+
+// leaseRecord is the lease on a binding. Expiry is stored in milliseconds
+// since the epoch, so that conditions on it compare numerically.
+type leaseRecord struct {
+	PK          *leasePK  `dynamo:"pk,hash"`
+	BindingID   uuid.UUID `dynamo:"sk,range"`
+	PeerID      uuid.UUID `dynamo:"peer_id"`
+	Token       int64     `dynamo:"token"`
+	ExpiresAt   int64     `dynamo:"expires_at"`
+	DeleteAfter time.Time `dynamo:"delete_after,unixtime"`
+}
+
+func (r *leaseRecord) toLease() *repositories.Lease {
+	return &repositories.Lease{
+		BindingID: r.BindingID,
+		PeerID:    r.PeerID,
+		Token:     r.Token,
+		ExpiresAt: time.UnixMilli(r.ExpiresAt),
+	}
+}
+
+type leasePK struct{}
+
+func (*leasePK) MarshalDynamo() (*dynamodb.AttributeValue, error) {
+	return &dynamodb.AttributeValue{
+		S: aws.String("LEASE"),
+	}, nil
+}
+
+func (*leasePK) UnmarshalDynamo(av *dynamodb.AttributeValue) error {
+	if av == nil || av.S == nil || *av.S != "LEASE" {
+		return fmt.Errorf("invalid leasePK: %v", av)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package dynamo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/guregu/dynamo"
+)
+
+var _ repositories.Leases = (*Leases)(nil)
+
+// leaseRecordTTL is how long lease records outlive their expiry, cleaning up
+// after deleted bindings. Stats writes are fenced by the lease token, which
+// starts again from one if the lease record is deleted, so lease records must
+// outlive stats records, by a margin as expired items are deleted lazily.
+const leaseRecordTTL = 2 * statsRecordTTL
+
+type Leases struct {
+	db        *dynamo.DB
+	tableName string
+	recordTTL time.Duration
+}
+
+func (l *Leases) AcquireLease(ctx context.Context, bindingID, peerID uuid.UUID, ttl time.Duration) (*repositories.Lease, error) {
+	now := time.Now()
+
+	query := l.db.
+		Table(l.tableName).
+		Update("pk", &leasePK{}).
+		Range("sk", bindingID).
+		Set("peer_id", peerID).
+		Set("expires_at", now.Add(ttl).UnixMilli()).
+		Set("delete_after", now.Add(ttl+l.recordTTL)).
+		Add("token", 1).
+		If("attribute_not_exists(pk) OR expires_at <= ? OR peer_id = ?", now.UnixMilli(), peerID)
+
+	var record leaseRecord
+	if err := query.ValueWithContext(ctx, &record); err != nil {
+		if dynamo.IsCondCheckFailed(err) {
+			return nil, repositories.ErrLeaseHeld
+		}
+
+		return nil, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	return record.toLease(), nil
+}
+
+func (l *Leases) RenewLease(ctx context.Context, lease repositories.Lease, ttl time.Duration) (*repositories.Lease, error) {
+	now := time.Now()
+
+	query := l.db.
+		Table(l.tableName).
+		Update("pk", &leasePK{}).
+		Range("sk", lease.BindingID).
+		Set("expires_at", now.Add(ttl).UnixMilli()).
+		Set("delete_after", now.Add(ttl+l.recordTTL)).
+		If("peer_id = ? AND token = ?", lease.PeerID, lease.Token)
+
+	var record leaseRecord
+	if err := query.ValueWithContext(ctx, &record); err != nil {
+		if dynamo.IsCondCheckFailed(err) {
+			return nil, repositories.ErrLeaseLost
+		}
+
+		return nil, fmt.Errorf("failed to renew lease: %w", err)
+	}
+
+	return record.toLease(), nil
+}
+
+func (l *Leases) ReleaseLease(ctx context.Context, lease repositories.Lease) error {
+	// The record is kept, rather than deleted, so that fencing tokens keep
+	// increasing for the next holder
+	query := l.db.
+		Table(l.tableName).
+		Update("pk", &leasePK{}).
+		Range("sk", lease.BindingID).
+		Set("expires_at", time.Now().UnixMilli()).
+		If("peer_id = ? AND token = ?", lease.PeerID, lease.Token)
+
+	if err := query.RunWithContext(ctx); err != nil {
+		if dynamo.IsCondCheckFailed(err) {
+			return repositories.ErrLeaseLost
+		}
+
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+
+	return nil
+}
+
+func NewLeases(db *dynamo.DB, tableName string) (*Leases, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := db.Table(tableName).WaitWithContext(ctx); err != nil {
+		return nil, fmt.Errorf("failed to wait for table %s: %w", tableName, err)
+	}
+
+	return &Leases{
+		db:        db,
+		tableName: tableName,
+		recordTTL: leaseRecordTTL,
+	}, nil
+}
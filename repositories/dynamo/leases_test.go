@@ -0,0 +1,40 @@
+package dynamo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNewLeases(t *testing.T) {
+	db := testingDynamoDB(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := CreateTable(ctx, db, "test-table")
+	require.NoError(t, err)
+
+	table, err := NewLeases(db, "test-table")
+	require.NoError(t, err)
+	require.NotNil(t, table)
+}
+
+func TestLeasesConformance(t *testing.T) {
+	db := testingDynamoDB(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := CreateTable(ctx, db, "test-table")
+	require.NoError(t, err)
+
+	leases, err := NewLeases(db, "test-table")
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewLeasesConformanceSuite(leases))
+}
@@ -0,0 +1,51 @@
+package dynamo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/guregu/dynamo"
+)
+
+// MigrateBindings rewrites binding records written before bindings had typed
+// targets, which give only the lambda_arn attribute, to use a lambda target.
+// Such records are read as lambda targets either way, so the migration can be
+// run while peers are serving. It returns the number of records migrated.
+func MigrateBindings(ctx context.Context, db *dynamo.DB, tableName string) (int, error) {
+	var legacy []jetstreamBindingRecord
+	err := db.Table(tableName).
+		Get("pk", &jetstreamBindingPK{}).
+		Filter("attribute_exists(lambda_arn)").
+		AllWithContext(ctx, &legacy)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list bindings to migrate: %w", err)
+	}
+
+	var migrated int
+	for _, record := range legacy {
+		err := db.Table(tableName).
+			Update("pk", &jetstreamBindingPK{}).
+			Range("sk", record.ID).
+			Set("target_type", repositories.TargetLambda).
+			Set("target_uri", record.LambdaARN).
+			Set("updated_at", time.Now()).
+			Remove("lambda_arn").
+			If("lambda_arn = ?", record.LambdaARN).
+			RunWithContext(ctx)
+		if err != nil {
+			// The binding was updated or deleted since it was listed, and so
+			// no longer needs migrating
+			if dynamo.IsCondCheckFailed(err) {
+				continue
+			}
+
+			return migrated, fmt.Errorf("failed to migrate binding %s: %w", record.ID, err)
+		}
+
+		migrated++
+	}
+
+	return migrated, nil
+}
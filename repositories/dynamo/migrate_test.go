@@ -0,0 +1,124 @@
+package dynamo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/guregu/dynamo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateBindings(t *testing.T) {
+	db := testingDynamoDB(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := CreateTable(ctx, db, "test-table")
+	require.NoError(t, err)
+
+	bindings, err := NewBindings(db, "test-table")
+	require.NoError(t, err)
+
+	// A record written before bindings had typed targets
+	id := uuid.New()
+	err = db.Table("test-table").Put(&jetstreamBindingRecord{
+		PK:             &jetstreamBindingPK{},
+		ID:             id,
+		LambdaARN:      "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+		Stream:         "my-stream",
+		Consumer:       id,
+		SubjectPattern: "my-subject",
+		DeliveryPolicy: deliveryPolicy{Deliver: repositories.DeliverAll},
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}).RunWithContext(ctx)
+	require.NoError(t, err)
+
+	expected := repositories.Target{
+		Type: repositories.TargetLambda,
+		URI:  "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+	}
+
+	// Legacy records are readable before they are migrated
+	jb, err := bindings.GetJetstreamBinding(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, expected, jb.Target)
+
+	migrated, err := MigrateBindings(ctx, db, "test-table")
+	require.NoError(t, err)
+	assert.Equal(t, 1, migrated)
+
+	jb, err = bindings.GetJetstreamBinding(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, expected, jb.Target)
+
+	var record jetstreamBindingRecord
+	err = db.Table("test-table").Get("pk", &jetstreamBindingPK{}).Range("sk", dynamo.Equal, id.String()).OneWithContext(ctx, &record)
+	require.NoError(t, err)
+	assert.Empty(t, record.LambdaARN)
+	assert.Equal(t, expected.URI, record.TargetURI)
+
+	// Migrating again does nothing
+	migrated, err = MigrateBindings(ctx, db, "test-table")
+	require.NoError(t, err)
+	assert.Equal(t, 0, migrated)
+}
+
+func TestLegacyDeliveryPolicy(t *testing.T) {
+	db := testingDynamoDB(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := CreateTable(ctx, db, "test-table")
+	require.NoError(t, err)
+
+	bindings, err := NewBindings(db, "test-table")
+	require.NoError(t, err)
+
+	jb, err := bindings.CreateJetstreamBinding(ctx, &repositories.CreateJetstreamBinding{
+		Target:         repositories.Target{Type: repositories.TargetLambda, URI: "my-function"},
+		Stream:         "my-stream",
+		Subject:        "my-subject",
+		DeliveryPolicy: repositories.DeliveryPolicy{Deliver: repositories.DeliverNew},
+	})
+	require.NoError(t, err)
+
+	// Any string was stored before delivery policies were implemented
+	err = db.Table("test-table").
+		Update("pk", &jetstreamBindingPK{}).
+		Range("sk", jb.ID).
+		Set("delivery_policy", "everything").
+		RunWithContext(ctx)
+	require.NoError(t, err)
+
+	listed, err := bindings.ListJetstreamBindings(ctx)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, repositories.DeliveryPolicy{Deliver: repositories.DeliverAll}, listed[0].DeliveryPolicy)
+}
+
+func TestDeliveryPolicy_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected repositories.DeliveryPolicy
+	}{
+		{input: "new", expected: repositories.DeliveryPolicy{Deliver: repositories.DeliverNew}},
+		{input: "42", expected: repositories.DeliveryPolicy{Deliver: repositories.DeliverByStartSequence, StartSequence: 42}},
+		{input: "", expected: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll}},
+		{input: "everything", expected: repositories.DeliveryPolicy{Deliver: repositories.DeliverAll}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var policy deliveryPolicy
+			require.NoError(t, policy.UnmarshalText([]byte(tt.input)))
+			assert.Equal(t, tt.expected, repositories.DeliveryPolicy(policy))
+		})
+	}
+}
@@ -4,18 +4,33 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/JoeReid/jetbridge/repositories"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/google/uuid"
 )
 
 type peerRecord struct {
-	PK          *peerPK   `dynamo:"pk,hash"`
-	ID          uuid.UUID `dynamo:"sk,range"`
-	Name        string    `dynamo:"name" localIndex:"name-index"`
-	CreatedAt   time.Time `dynamo:"created_at" localIndex:"created_at-index"`
-	UpdatedAt   time.Time `dynamo:"updated_at" localIndex:"updated_at-index"`
-	DeleteAfter time.Time `dynamo:"delete_after,unixtime"`
+	PK          *peerPK             `dynamo:"pk,hash"`
+	ID          uuid.UUID           `dynamo:"sk,range"`
+	Name        string              `dynamo:"name" localIndex:"name-index"`
+	CreatedAt   time.Time           `dynamo:"created_at" localIndex:"created_at-index"`
+	UpdatedAt   time.Time           `dynamo:"updated_at" localIndex:"updated_at-index"`
+	DeleteAfter time.Time           `dynamo:"delete_after,unixtime"`
+	Weight      float64             `dynamo:"weight"`
+	Labels      repositories.Labels `dynamo:"labels"`
+}
+
+func (r *peerRecord) toPeer() *repositories.Peer {
+	return &repositories.Peer{
+		ID:             r.ID,
+		Hostname:       r.Name,
+		JoinedAt:       r.CreatedAt,
+		LastSeenAt:     r.UpdatedAt,
+		HeartbeatDueBy: r.DeleteAfter,
+		Weight:         r.Weight,
+		Labels:         r.Labels,
+	}
 }
 
 type peerPK struct{}
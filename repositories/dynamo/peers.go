@@ -19,7 +19,7 @@ type Peers struct {
 	peerTTL   time.Duration
 }
 
-func (s *Peers) JoinPeers(ctx context.Context) (*repositories.Peer, error) {
+func (s *Peers) JoinPeers(ctx context.Context, join *repositories.JoinPeer) (*repositories.Peer, error) {
 	hostname, err := os.Hostname()
 	if err != nil {
 		return nil, err
@@ -32,6 +32,8 @@ func (s *Peers) JoinPeers(ctx context.Context) (*repositories.Peer, error) {
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		DeleteAfter: time.Now().Add(s.peerTTL),
+		Weight:      join.Weight,
+		Labels:      join.Labels,
 	}
 
 	query := s.db.
@@ -43,13 +45,7 @@ func (s *Peers) JoinPeers(ctx context.Context) (*repositories.Peer, error) {
 		return nil, err
 	}
 
-	return &repositories.Peer{
-		ID:             record.ID,
-		Hostname:       record.Name,
-		JoinedAt:       record.CreatedAt,
-		LastSeenAt:     record.UpdatedAt,
-		HeartbeatDueBy: record.DeleteAfter,
-	}, nil
+	return record.toPeer(), nil
 }
 
 func (s *Peers) SendHeartbeat(ctx context.Context, id uuid.UUID) (*repositories.Peer, error) {
@@ -66,13 +62,7 @@ func (s *Peers) SendHeartbeat(ctx context.Context, id uuid.UUID) (*repositories.
 		return nil, err
 	}
 
-	return &repositories.Peer{
-		ID:             row.ID,
-		Hostname:       row.Name,
-		JoinedAt:       row.CreatedAt,
-		LastSeenAt:     row.UpdatedAt,
-		HeartbeatDueBy: row.DeleteAfter,
-	}, nil
+	return row.toPeer(), nil
 }
 
 func (s *Peers) LeavePeers(ctx context.Context, id uuid.UUID) error {
@@ -101,13 +91,7 @@ func (s *Peers) ListPeers(ctx context.Context) ([]repositories.Peer, error) {
 
 	var peers []repositories.Peer
 	for _, row := range rows {
-		peers = append(peers, repositories.Peer{
-			ID:             row.ID,
-			Hostname:       row.Name,
-			JoinedAt:       row.CreatedAt,
-			LastSeenAt:     row.UpdatedAt,
-			HeartbeatDueBy: row.DeleteAfter,
-		})
+		peers = append(peers, *row.toPeer())
 	}
 
 	return peers, nil
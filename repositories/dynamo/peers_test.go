@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
 	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
 )
 
 func TestNewPeers(t *testing.T) {
@@ -21,3 +23,18 @@ func TestNewPeers(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, table)
 }
+
+func TestPeersConformance(t *testing.T) {
+	db := testingDynamoDB(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := CreateTable(ctx, db, "test-table")
+	require.NoError(t, err)
+
+	peers, err := NewPeers(db, "test-table")
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewPeersConformanceSuite(peers))
+}
@@ -0,0 +1,90 @@
+package dynamo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/guregu/dynamo"
+)
+
+var _ repositories.Stats = (*Stats)(nil)
+
+// statsRecordTTL is how long stats records outlive their last write, which
+// outlives any running worker, cleaning up after deleted bindings.
+const statsRecordTTL = 7 * 24 * time.Hour
+
+type Stats struct {
+	db        *dynamo.DB
+	tableName string
+	statsTTL  time.Duration
+}
+
+func (s *Stats) RecordBindingStats(ctx context.Context, stats *repositories.BindingStats) error {
+	query := s.db.
+		Table(s.tableName).
+		Update("pk", &bindingStatsPK{}).
+		Range("sk", stats.BindingID).
+		Set("peer_id", stats.PeerID).
+		Set("lease_token", stats.LeaseToken).
+		Set("running_until", stats.RunningUntil).
+		Set("updated_at", stats.RecordedAt).
+		Set("delete_after", stats.RecordedAt.Add(s.statsTTL)).
+		If("attribute_not_exists(lease_token) OR lease_token <= ?", stats.LeaseToken)
+
+	if !stats.LastSuccessAt.IsZero() {
+		query = query.Set("last_success_at", stats.LastSuccessAt)
+	}
+
+	if !stats.LastErrorAt.IsZero() {
+		query = query.
+			Set("last_error_at", stats.LastErrorAt).
+			Set("last_error", stats.LastError)
+	}
+
+	if err := query.RunWithContext(ctx); err != nil {
+		if dynamo.IsCondCheckFailed(err) {
+			return repositories.ErrLeaseLost
+		}
+
+		return fmt.Errorf("failed to record binding stats: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Stats) GetBindingStats(ctx context.Context, bindingID uuid.UUID) (*repositories.BindingStats, error) {
+	query := s.db.
+		Table(s.tableName).
+		Get("pk", &bindingStatsPK{}).
+		Range("sk", dynamo.Equal, bindingID.String())
+
+	var record bindingStatsRecord
+	if err := query.OneWithContext(ctx, &record); err != nil {
+		if errors.Is(err, dynamo.ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return record.toBindingStats(), nil
+}
+
+func NewStats(db *dynamo.DB, tableName string) (*Stats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := db.Table(tableName).WaitWithContext(ctx); err != nil {
+		return nil, fmt.Errorf("failed to wait for table %s: %w", tableName, err)
+	}
+
+	return &Stats{
+		db:        db,
+		tableName: tableName,
+		statsTTL:  statsRecordTTL,
+	}, nil
+}
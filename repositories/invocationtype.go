@@ -0,0 +1,22 @@
+package repositories
+
+// InvocationType selects how a binding's Lambda is invoked, using the values
+// of the Lambda InvocationType API parameter. It also determines when the
+// messages passed to the Lambda are ACK'd.
+//
+// The zero value is equivalent to InvocationRequestResponse.
+type InvocationType string
+
+const (
+	// InvocationRequestResponse waits for the function to finish, ACK-ing
+	// messages once it succeeds and NAK-ing them if it fails.
+	InvocationRequestResponse InvocationType = "RequestResponse"
+	// InvocationEvent queues the event with Lambda and ACKs messages as soon
+	// as Lambda accepts it. Failures inside the function are left to its own
+	// asynchronous retry and destination configuration.
+	InvocationEvent InvocationType = "Event"
+	// InvocationDryRun validates the invocation without running the
+	// function, ACK-ing messages once it is valid and NAK-ing them if not.
+	// Messages are consumed without being processed.
+	InvocationDryRun InvocationType = "DryRun"
+)
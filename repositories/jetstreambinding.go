@@ -1,28 +1,219 @@
 package repositories
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
 )
 
+// MaxConcurrencyLimit is the highest MaxConcurrency a binding may have.
+// Consumers are created accepting this many concurrent fetches, as the limit
+// cannot be raised on an existing consumer.
+const MaxConcurrencyLimit = 256
+
 type JetstreamBinding struct {
 	ID             uuid.UUID
-	LambdaARN      string
+	Target         Target
 	Stream         string
 	Consumer       uuid.UUID
 	Subject        string
 	MaxMessages    int
 	MaxLatency     time.Duration
-	DeliveryPolicy string
+	DeliveryPolicy DeliveryPolicy
 	AssignedPeerID *uuid.UUID
+
+	// MaxDeliveries limits how many times a message is delivered before it is
+	// given up on. Zero means messages are redelivered indefinitely.
+	MaxDeliveries int
+	// DeadLetterSubject, if set, is the subject that messages exceeding
+	// MaxDeliveries are published to before being terminated.
+	DeadLetterSubject string
+	// RetryPolicy controls the delay before failed messages are redelivered.
+	RetryPolicy RetryPolicy
+	// InvocationType controls how the Lambda is invoked and when messages are
+	// ACK'd. It only applies to Lambda targets.
+	InvocationType InvocationType
+	// PayloadFormat controls how messages are encoded for the target.
+	PayloadFormat PayloadFormat
+	// MaxConcurrency is the number of batches that may be in flight at once.
+	// Values of one or less preserve strict ordering of the stream.
+	MaxConcurrency int
+	// PartitionKey, if set, splits each fetched batch by key so that only
+	// messages sharing a key are kept in order.
+	PartitionKey PartitionKey
+	// Paused bindings are not run by any peer, but keep their consumer so that
+	// delivery continues from the same position once resumed.
+	Paused bool
+	// RequiredLabels restricts the binding to peers having all of the labels.
+	RequiredLabels Labels
 }
 
 type CreateJetstreamBinding struct {
-	LambdaARN      string
-	Stream         string
-	Subject        string
-	MaxMessages    int
-	MaxLatency     time.Duration
-	DeliveryPolicy string
+	Target            Target
+	Stream            string
+	Subject           string
+	MaxMessages       int
+	MaxLatency        time.Duration
+	DeliveryPolicy    DeliveryPolicy
+	MaxDeliveries     int
+	DeadLetterSubject string
+	RetryPolicy       RetryPolicy
+	InvocationType    InvocationType
+	PayloadFormat     PayloadFormat
+	MaxConcurrency    int
+	PartitionKey      PartitionKey
+	RequiredLabels    Labels
+}
+
+// Binding returns the binding that would be created, without the ID and
+// consumer that are assigned on creation.
+func (c *CreateJetstreamBinding) Binding() JetstreamBinding {
+	return JetstreamBinding{
+		Target:            c.Target,
+		Stream:            c.Stream,
+		Subject:           c.Subject,
+		MaxMessages:       c.MaxMessages,
+		MaxLatency:        c.MaxLatency,
+		DeliveryPolicy:    c.DeliveryPolicy,
+		MaxDeliveries:     c.MaxDeliveries,
+		DeadLetterSubject: c.DeadLetterSubject,
+		RetryPolicy:       c.RetryPolicy,
+		InvocationType:    c.InvocationType,
+		PayloadFormat:     c.PayloadFormat,
+		MaxConcurrency:    c.MaxConcurrency,
+		PartitionKey:      c.PartitionKey,
+		RequiredLabels:    maps.Clone(c.RequiredLabels),
+	}
+}
+
+// UpdateJetstreamBinding describes a partial update to an existing binding.
+// Nil fields are left unchanged. The stream, consumer and delivery policy of a
+// binding cannot be updated, as doing so would invalidate the consumer position.
+type UpdateJetstreamBinding struct {
+	Target            *Target
+	Subject           *string
+	MaxMessages       *int
+	MaxLatency        *time.Duration
+	MaxDeliveries     *int
+	DeadLetterSubject *string
+	RetryPolicy       *RetryPolicy
+	InvocationType    *InvocationType
+	PayloadFormat     *PayloadFormat
+	MaxConcurrency    *int
+	PartitionKey      *PartitionKey
+	Paused            *bool
+	RequiredLabels    *Labels
+}
+
+// Apply sets the fields of the binding that are set in the update.
+func (b *JetstreamBinding) Apply(update *UpdateJetstreamBinding) {
+	if update.Target != nil {
+		b.Target = *update.Target
+	}
+
+	if update.Subject != nil {
+		b.Subject = *update.Subject
+	}
+
+	if update.MaxMessages != nil {
+		b.MaxMessages = *update.MaxMessages
+	}
+
+	if update.MaxLatency != nil {
+		b.MaxLatency = *update.MaxLatency
+	}
+
+	if update.MaxDeliveries != nil {
+		b.MaxDeliveries = *update.MaxDeliveries
+	}
+
+	if update.DeadLetterSubject != nil {
+		b.DeadLetterSubject = *update.DeadLetterSubject
+	}
+
+	if update.RetryPolicy != nil {
+		b.RetryPolicy = *update.RetryPolicy
+	}
+
+	if update.InvocationType != nil {
+		b.InvocationType = *update.InvocationType
+	}
+
+	if update.PayloadFormat != nil {
+		b.PayloadFormat = *update.PayloadFormat
+	}
+
+	if update.MaxConcurrency != nil {
+		b.MaxConcurrency = *update.MaxConcurrency
+	}
+
+	if update.PartitionKey != nil {
+		b.PartitionKey = *update.PartitionKey
+	}
+
+	if update.Paused != nil {
+		b.Paused = *update.Paused
+	}
+
+	if update.RequiredLabels != nil {
+		b.RequiredLabels = maps.Clone(*update.RequiredLabels)
+	}
+}
+
+// ValidateUpdate checks the binding would still be valid after applying the
+// update, returning an error wrapping ErrInvalidBinding if not. Repositories
+// check this within their update, as the result depends on the settings the
+// update does not change.
+func (b JetstreamBinding) ValidateUpdate(update *UpdateJetstreamBinding) error {
+	b.Apply(update)
+	if err := b.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBinding, err)
+	}
+
+	return nil
+}
+
+// Validate checks the settings of the binding are consistent with each other.
+// Its target is validated separately, by Target.Validate.
+func (b JetstreamBinding) Validate() error {
+	switch {
+	case b.MaxMessages < 0:
+		return errors.New("max batch size must not be negative")
+	case b.MaxLatency < 0:
+		return errors.New("max batch latency must not be negative")
+	case b.MaxDeliveries < 0:
+		return errors.New("max deliveries must not be negative")
+	case b.MaxConcurrency < 0:
+		return errors.New("max concurrency must not be negative")
+	case b.MaxConcurrency > MaxConcurrencyLimit:
+		return fmt.Errorf("max concurrency must not exceed %d", MaxConcurrencyLimit)
+	case b.DeadLetterSubject != "" && b.MaxDeliveries == 0:
+		return errors.New("dead letter subject requires max deliveries to be set")
+	case !b.PartitionKey.IsZero() && !b.Batched():
+		return errors.New("partition key requires max batch size and latency to be set")
+	case b.PayloadFormat == PayloadFormatRaw && b.Batched():
+		return ErrRawBatch
+	}
+
+	return nil
+}
+
+// Batched reports whether the binding's messages are delivered in batches,
+// rather than one at a time.
+func (b JetstreamBinding) Batched() bool {
+	return b.MaxMessages > 0 && b.MaxLatency > 0
+}
+
+// Concurrency is the number of batches of the binding that may be processed
+// in parallel, accounting for MaxConcurrency being unset. Partitioned bindings
+// process one batch at a time, so that each key stays ordered across batches,
+// and instead gain parallelism from the partitions within a batch.
+func (b JetstreamBinding) Concurrency() int {
+	if b.MaxConcurrency > 1 && b.PartitionKey.IsZero() {
+		return b.MaxConcurrency
+	}
+	return 1
 }
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJetstreamBinding_Apply(t *testing.T) {
+	binding := JetstreamBinding{
+		Subject:           "orders.>",
+		MaxMessages:       10,
+		MaxLatency:        time.Second,
+		MaxDeliveries:     5,
+		DeadLetterSubject: "orders-dlq",
+	}
+
+	maxMessages := 0
+	deadLetterSubject := ""
+	binding.Apply(&UpdateJetstreamBinding{
+		MaxMessages:       &maxMessages,
+		DeadLetterSubject: &deadLetterSubject,
+	})
+
+	assert.Equal(t, JetstreamBinding{
+		Subject:       "orders.>",
+		MaxLatency:    time.Second,
+		MaxDeliveries: 5,
+	}, binding)
+}
+
+func TestJetstreamBinding_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		binding JetstreamBinding
+		valid   bool
+	}{
+		{name: "raw", binding: JetstreamBinding{PayloadFormat: PayloadFormatRaw}, valid: true},
+		{name: "raw batched", binding: JetstreamBinding{MaxMessages: 10, MaxLatency: time.Second, PayloadFormat: PayloadFormatRaw}, valid: false},
+		{name: "zero value", binding: JetstreamBinding{}, valid: true},
+		{name: "batched", binding: JetstreamBinding{MaxMessages: 10, MaxLatency: time.Second}, valid: true},
+		{name: "negative max batch size", binding: JetstreamBinding{MaxMessages: -1}, valid: false},
+		{name: "negative max batch latency", binding: JetstreamBinding{MaxLatency: -time.Second}, valid: false},
+		{name: "negative max deliveries", binding: JetstreamBinding{MaxDeliveries: -1}, valid: false},
+		{name: "negative max concurrency", binding: JetstreamBinding{MaxConcurrency: -1}, valid: false},
+		{name: "max concurrency at limit", binding: JetstreamBinding{MaxConcurrency: MaxConcurrencyLimit}, valid: true},
+		{name: "max concurrency over limit", binding: JetstreamBinding{MaxConcurrency: MaxConcurrencyLimit + 1}, valid: false},
+		{name: "dead letter subject", binding: JetstreamBinding{MaxDeliveries: 5, DeadLetterSubject: "dlq"}, valid: true},
+		{name: "dead letter subject without max deliveries", binding: JetstreamBinding{DeadLetterSubject: "dlq"}, valid: false},
+		{name: "partition key", binding: JetstreamBinding{MaxMessages: 10, MaxLatency: time.Second, PartitionKey: PartitionKey{SubjectToken: 2}}, valid: true},
+		{name: "partition key without batching", binding: JetstreamBinding{PartitionKey: PartitionKey{SubjectToken: 2}}, valid: false},
+		{name: "partition key without max batch latency", binding: JetstreamBinding{MaxMessages: 10, PartitionKey: PartitionKey{Header: "Customer-Id"}}, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.binding.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
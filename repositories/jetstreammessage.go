@@ -1,6 +1,11 @@
 package repositories
 
-import "github.com/JoeReid/jetbridge"
+import (
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/nats-io/nats.go"
+)
 
 //go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_jetstreammessage.go -package=mocks . JetstreamMessage
 
@@ -8,4 +13,11 @@ type JetstreamMessage interface {
 	Payload() jetbridge.JetstreamLambdaPayload
 	Ack() error
 	Nak() error
+	NakWithDelay(delay time.Duration) error
+	Term() error
+
+	// DeadLetter publishes a copy of the message to the given subject, with
+	// the extra headers merged into its original headers. It does not settle
+	// the message, callers should Term it once it is safely dead-lettered.
+	DeadLetter(subject string, header nats.Header) error
 }
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Labels are key/value pairs describing a peer, such as the region or VPC it
+// runs in. Bindings may require labels to restrict which peers run them.
+type Labels map[string]string
+
+// ParseLabels parses labels of the form key=value.
+func ParseLabels(labels []string) (Labels, error) {
+	parsed := make(Labels, len(labels))
+	for _, label := range labels {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid label %q, expected key=value", label)
+		}
+
+		parsed[key] = value
+	}
+
+	return parsed, nil
+}
+
+// Matches reports whether l has every label in required.
+func (l Labels) Matches(required Labels) bool {
+	for key, value := range required {
+		if v, ok := l[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels([]string{"region=eu-west-1", "vpc=vpc-1234", "empty="})
+	require.NoError(t, err)
+	assert.Equal(t, Labels{"region": "eu-west-1", "vpc": "vpc-1234", "empty": ""}, labels)
+
+	_, err = ParseLabels([]string{"region"})
+	assert.Error(t, err)
+
+	_, err = ParseLabels([]string{"=eu-west-1"})
+	assert.Error(t, err)
+}
+
+func TestLabels_Matches(t *testing.T) {
+	labels := Labels{"region": "eu-west-1", "vpc": "vpc-1234"}
+
+	assert.True(t, labels.Matches(nil))
+	assert.True(t, labels.Matches(Labels{"region": "eu-west-1"}))
+	assert.True(t, labels.Matches(Labels{"region": "eu-west-1", "vpc": "vpc-1234"}))
+	assert.False(t, labels.Matches(Labels{"region": "us-east-1"}))
+	assert.False(t, labels.Matches(Labels{"zone": "a"}))
+	assert.False(t, Labels(nil).Matches(Labels{"region": "eu-west-1"}))
+}
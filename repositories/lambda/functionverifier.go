@@ -0,0 +1,62 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+)
+
+var _ repositories.TargetVerifier = (*FunctionVerifier)(nil)
+
+// FunctionVerifier checks that the functions of Lambda targets exist, which
+// requires the lambda:GetFunction permission.
+type FunctionVerifier struct {
+	lambda lambdaiface.LambdaAPI
+}
+
+func (f *FunctionVerifier) VerifyTarget(ctx context.Context, target repositories.Target) error {
+	if target.Type != "" && target.Type != repositories.TargetLambda {
+		return nil
+	}
+
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(target.URI),
+	}
+
+	if target.Qualifier != "" {
+		input.Qualifier = aws.String(target.Qualifier)
+	}
+
+	if _, err := f.lambda.GetFunctionWithContext(ctx, input); err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == lambda.ErrCodeResourceNotFoundException {
+			return fmt.Errorf("%w: lambda function %s", repositories.ErrTargetNotFound, qualifiedName(target))
+		}
+
+		return fmt.Errorf("failed to get lambda function %s: %w", qualifiedName(target), err)
+	}
+
+	return nil
+}
+
+func NewFunctionVerifier(lambda lambdaiface.LambdaAPI) (*FunctionVerifier, error) {
+	return &FunctionVerifier{
+		lambda: lambda,
+	}, nil
+}
+
+// qualifiedName returns the function of a target, followed by its qualifier
+// if it has one.
+func qualifiedName(target repositories.Target) string {
+	if target.Qualifier == "" {
+		return target.URI
+	}
+
+	return target.URI + ":" + target.Qualifier
+}
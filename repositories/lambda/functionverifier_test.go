@@ -0,0 +1,94 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGetFunction struct {
+	lambdaiface.LambdaAPI
+
+	input *lambda.GetFunctionInput
+	err   error
+}
+
+func (f *fakeGetFunction) GetFunctionWithContext(_ aws.Context, input *lambda.GetFunctionInput, _ ...request.Option) (*lambda.GetFunctionOutput, error) {
+	f.input = input
+	return &lambda.GetFunctionOutput{}, f.err
+}
+
+func TestFunctionVerifier_VerifyTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      repositories.Target
+		err         error
+		wantErr     bool
+		notFound    bool
+		wantRequest bool
+	}{
+		{
+			name:        "exists",
+			target:      repositories.Target{Type: repositories.TargetLambda, URI: "my-function", Qualifier: "live"},
+			wantRequest: true,
+		},
+		{
+			name:        "not found",
+			target:      repositories.Target{Type: repositories.TargetLambda, URI: "my-function"},
+			err:         awserr.New(lambda.ErrCodeResourceNotFoundException, "Function not found", nil),
+			wantErr:     true,
+			notFound:    true,
+			wantRequest: true,
+		},
+		{
+			name:        "access denied",
+			target:      repositories.Target{URI: "my-function"},
+			err:         awserr.New("AccessDeniedException", "not authorized", nil),
+			wantErr:     true,
+			wantRequest: true,
+		},
+		{
+			name:   "webhook",
+			target: repositories.Target{Type: repositories.TargetWebhook, URI: "https://example.com/hook"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeGetFunction{err: tt.err}
+
+			candidate, err := NewFunctionVerifier(fake)
+			require.NoError(t, err)
+
+			err = candidate.VerifyTarget(context.TODO(), tt.target)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.notFound, errors.Is(err, repositories.ErrTargetNotFound))
+
+			if !tt.wantRequest {
+				assert.Nil(t, fake.input)
+				return
+			}
+
+			require.NotNil(t, fake.input)
+			assert.Equal(t, tt.target.URI, aws.StringValue(fake.input.FunctionName))
+			if tt.target.Qualifier != "" {
+				assert.Equal(t, tt.target.Qualifier, aws.StringValue(fake.input.Qualifier))
+			} else {
+				assert.Nil(t, fake.input.Qualifier)
+			}
+		})
+	}
+}
@@ -5,11 +5,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/metrics"
 	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/settle"
+	"github.com/JoeReid/jetbridge/tracing"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -21,116 +29,143 @@ type MessageHandler struct {
 }
 
 func (m *MessageHandler) HandleJetstreamMessages(ctx context.Context, binding repositories.JetstreamBinding, messages []repositories.JetstreamMessage) error {
-	if binding.MaxMessages > 0 && binding.MaxLatency > 0 {
-		var batch jetbridge.JetstreamBatchedLambdaPayload
-		for _, message := range messages {
-			batch = append(batch, message.Payload())
-		}
+	return settle.Dispatch(ctx, m.bindingLogger(binding), binding, messages, func(ctx context.Context, payload []byte, _ string) ([]byte, error) {
+		return m.send(ctx, binding, payload)
+	})
+}
 
-		payload, err := json.Marshal(batch)
-		if err != nil {
-			for _, message := range messages {
-				if err := message.Nak(); err != nil {
-					m.logger.Error("failed to NAK message", zap.Error(err))
-				}
-			}
+// send invokes the binding's function with the payload, returning the
+// response the messages are settled by.
+func (m *MessageHandler) send(ctx context.Context, binding repositories.JetstreamBinding, payload []byte) ([]byte, error) {
+	// Lambda only accepts JSON payloads, so retrying the message would never
+	// succeed
+	if binding.PayloadFormat == repositories.PayloadFormatRaw && !json.Valid(payload) {
+		return nil, settle.Reject(errors.New("raw message data is not JSON"))
+	}
 
-			return fmt.Errorf("failed to marshal message: %w", err)
-		}
+	out, err := m.run(ctx, binding, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run lambda: %w", err)
+	}
 
-		if err := m.run(ctx, binding, payload); err != nil {
-			for _, message := range messages {
-				if err := message.Nak(); err != nil {
-					m.logger.Error("failed to NAK message", zap.Error(err))
-				}
-			}
+	switch binding.InvocationType {
+	case repositories.InvocationEvent:
+		// Lambda has accepted the event, it now owns any retries
+		return nil, nil
 
-			return fmt.Errorf("failed to run lambda: %w", err)
-		}
+	case repositories.InvocationDryRun:
+		// The invocation is valid, and redelivering it would only validate
+		// it again
+		return nil, nil
 
-		return nil
+	default:
+		return out, nil
 	}
+}
 
-	var rtnErr error
-	for _, message := range messages {
-		if rtnErr != nil {
-			if err := message.Nak(); err != nil {
-				m.logger.Error("failed to NAK message", zap.Error(err))
-			}
-			continue
-		}
-
-		payload, err := json.Marshal(message.Payload())
-		if err != nil {
-			rtnErr = fmt.Errorf("failed to marshal message: %w", err)
+// maxFunctionErrorSize limits how much of a function's error payload is kept
+// in the error, which ends up in the headers of dead-lettered messages.
+const maxFunctionErrorSize = 1024
 
-			if err := message.Nak(); err != nil {
-				m.logger.Error("failed to NAK message", zap.Error(err))
-			}
+var lineBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
 
-			continue
-		}
+// functionError returns the error a function failed with, which is the kind
+// of error, Handled or Unhandled, followed by the error payload the function
+// returned. Line breaks are removed, so it may be used as a header value.
+func functionError(kind string, payload []byte) error {
+	if len(payload) > maxFunctionErrorSize {
+		payload = payload[:maxFunctionErrorSize]
+	}
 
-		if err := m.run(ctx, binding, payload); err != nil {
-			rtnErr = fmt.Errorf("failed to run lambda: %w", err)
+	detail := strings.TrimSpace(lineBreaks.Replace(string(payload)))
+	if detail == "" {
+		return errors.New(kind)
+	}
 
-			if err := message.Nak(); err != nil {
-				m.logger.Error("failed to NAK message", zap.Error(err))
-			}
+	return fmt.Errorf("%s: %s", kind, detail)
+}
 
-			continue
+func (m *MessageHandler) run(ctx context.Context, binding repositories.JetstreamBinding, payload []byte) (_ []byte, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "lambda.invoke", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("jetbridge.binding_id", binding.ID.String()),
+		attribute.String("faas.invoked_name", binding.Target.URI),
+		attribute.String("faas.invoked_qualifier", binding.Target.Qualifier),
+		attribute.String("faas.invocation_type", string(binding.InvocationType)),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 		}
+		span.End()
+	}()
 
-		if err := message.Ack(); err != nil {
-			m.logger.Error("failed to ACK message", zap.Error(err))
-			rtnErr = err
+	input := &lambda.InvokeInput{
+		FunctionName: &binding.Target.URI,
+		Payload:      payload,
+	}
 
-			continue
-		}
+	if binding.Target.Qualifier != "" {
+		input.Qualifier = &binding.Target.Qualifier
 	}
 
-	return rtnErr
-}
+	if binding.InvocationType != "" {
+		input.InvocationType = aws.String(string(binding.InvocationType))
+	}
+
+	// Forward the trace context so the function can continue the trace
+	clientContext, err := tracing.ClientContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode client context: %w", err)
+	}
+	input.ClientContext = clientContext
+
+	start := time.Now()
+	out, err := m.lambda.InvokeWithContext(ctx, input)
+	metrics.LambdaInvocationDuration.WithLabelValues(binding.Target.URI).Observe(time.Since(start).Seconds())
 
-func (m *MessageHandler) run(ctx context.Context, binding repositories.JetstreamBinding, payload []byte) error {
-	out, err := m.lambda.InvokeWithContext(ctx, &lambda.InvokeInput{
-		FunctionName: &binding.LambdaARN,
-		Payload:      payload,
-	})
 	if err != nil {
-		return err
+		metrics.LambdaInvocationErrors.WithLabelValues(binding.Target.URI).Inc()
+		return nil, err
 	}
 
 	if out.FunctionError != nil {
+		metrics.LambdaInvocationErrors.WithLabelValues(binding.Target.URI).Inc()
+
 		var logs string
 		if out.LogResult != nil {
 			logs = *out.LogResult
 		}
 
-		m.logger.Debug(
+		m.bindingLogger(binding).Debug(
 			"lambda returned error",
-			zap.String("function_name", binding.LambdaARN),
-			zap.String("function_version", *out.ExecutedVersion),
+			zap.String("function_version", aws.StringValue(out.ExecutedVersion)),
 			zap.String("error", *out.FunctionError),
 			zap.String("logs", logs),
 		)
-		return errors.New(*out.FunctionError)
+		return nil, functionError(*out.FunctionError, out.Payload)
 	}
 
-	m.logger.Info("lambda invoked successfully", zap.String("function_name", binding.LambdaARN), zap.String("function_version", *out.ExecutedVersion))
+	// ExecutedVersion is only returned for synchronous invocations
+	m.bindingLogger(binding).Debug("lambda invoked successfully", zap.String("function_version", aws.StringValue(out.ExecutedVersion)))
 
-	return nil
+	return out.Payload, nil
 }
 
-func NewMessageHandler(lambda lambdaiface.LambdaAPI) (*MessageHandler, error) {
-	zl, err := zap.NewDevelopment() // TODO: this needs to be managed better
-	if err != nil {
-		return nil, err
-	}
-	zl.With(zap.String("component", "lambda"))
-
+func NewMessageHandler(lambda lambdaiface.LambdaAPI, logger *zap.Logger) (*MessageHandler, error) {
 	return &MessageHandler{
-		logger: zl,
+		logger: logger.With(zap.String("component", "lambda")),
 		lambda: lambda,
 	}, nil
 }
+
+// bindingLogger returns a logger annotated with the fields identifying a binding
+// and the function it invokes.
+func (m *MessageHandler) bindingLogger(binding repositories.JetstreamBinding) *zap.Logger {
+	return m.logger.With(
+		zap.String("binding_id", binding.ID.String()),
+		zap.String("stream", binding.Stream),
+		zap.String("function_name", binding.Target.URI),
+		zap.String("qualifier", binding.Target.Qualifier),
+	)
+}
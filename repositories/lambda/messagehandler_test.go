@@ -0,0 +1,405 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/metrics"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeLambda struct {
+	lambdaiface.LambdaAPI
+
+	input  *lambda.InvokeInput
+	output *lambda.InvokeOutput
+	err    error
+}
+
+func (f *fakeLambda) InvokeWithContext(_ aws.Context, input *lambda.InvokeInput, _ ...request.Option) (*lambda.InvokeOutput, error) {
+	f.input = input
+	return f.output, f.err
+}
+
+func TestMessageHandler_HandleJetstreamMessages_batchResponse(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:          id,
+		Target:      repositories.Target{Type: repositories.TargetLambda, URI: "test-arn"},
+		Stream:      "test-stream",
+		Consumer:    id,
+		Subject:     "test-stream.*",
+		MaxMessages: 3,
+		MaxLatency:  time.Second,
+	}
+
+	tests := []struct {
+		name    string
+		payload string
+		expect  func(first, second, third *mocks.MockJetstreamMessage)
+		wantErr bool
+	}{
+		{
+			name:    "no response",
+			payload: "null",
+			expect: func(first, second, third *mocks.MockJetstreamMessage) {
+				first.EXPECT().Ack().Return(nil)
+				second.EXPECT().Ack().Return(nil)
+				third.EXPECT().Ack().Return(nil)
+			},
+		},
+		{
+			name:    "partial failure",
+			payload: `{"batchItemFailures":[{"sequence":2},{"sequence":3,"terminate":true}]}`,
+			expect: func(first, second, third *mocks.MockJetstreamMessage) {
+				first.EXPECT().Ack().Return(nil)
+				second.EXPECT().Nak().Return(nil)
+				third.EXPECT().Term().Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unknown sequence",
+			payload: `{"batchItemFailures":[{"sequence":4}]}`,
+			expect: func(first, second, third *mocks.MockJetstreamMessage) {
+				first.EXPECT().Nak().Return(nil)
+				second.EXPECT().Nak().Return(nil)
+				third.EXPECT().Nak().Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid response",
+			payload: `"ok"`,
+			expect: func(first, second, third *mocks.MockJetstreamMessage) {
+				first.EXPECT().Nak().Return(nil)
+				second.EXPECT().Nak().Return(nil)
+				third.EXPECT().Nak().Return(nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			first, second, third := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2), mocks.NewTestingJetstreamMessage(ctrl, 3)
+			tt.expect(first, second, third)
+
+			candidate := &MessageHandler{
+				logger: zap.NewNop(),
+				lambda: &fakeLambda{
+					output: &lambda.InvokeOutput{
+						ExecutedVersion: aws.String("$LATEST"),
+						Payload:         []byte(tt.payload),
+					},
+				},
+			}
+
+			err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second, third})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_functionError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:          id,
+		Target:      repositories.Target{Type: repositories.TargetLambda, URI: "function-error-arn"},
+		Stream:      "test-stream",
+		Consumer:    id,
+		Subject:     "test-stream.*",
+		MaxMessages: 2,
+		MaxLatency:  time.Second,
+	}
+
+	first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+	first.EXPECT().Nak().Return(nil)
+	second.EXPECT().Nak().Return(nil)
+
+	candidate := &MessageHandler{
+		logger: zap.NewNop(),
+		lambda: &fakeLambda{
+			output: &lambda.InvokeOutput{
+				ExecutedVersion: aws.String("$LATEST"),
+				FunctionError:   aws.String("Unhandled"),
+			},
+		},
+	}
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+	assert.ErrorContains(t, err, "Unhandled")
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.LambdaInvocationErrors.WithLabelValues("function-error-arn")))
+}
+
+func TestMessageHandler_HandleJetstreamMessages_deadLetter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:                id,
+		Target:            repositories.Target{Type: repositories.TargetLambda, URI: "test-arn"},
+		Stream:            "test-stream",
+		Consumer:          id,
+		Subject:           "test-stream.*",
+		MaxDeliveries:     3,
+		DeadLetterSubject: "test-dlq",
+	}
+
+	retried := mocks.NewMockJetstreamMessage(ctrl)
+	retried.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{
+		Subject:  "test-stream.1",
+		Metadata: nats.MsgMetadata{Stream: "test-stream", Sequence: nats.SequencePair{Stream: 1}, NumDelivered: 2},
+	}).AnyTimes()
+	retried.EXPECT().Nak().Return(nil)
+
+	exhausted := mocks.NewMockJetstreamMessage(ctrl)
+	exhausted.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{
+		Subject:  "test-stream.2",
+		Metadata: nats.MsgMetadata{Stream: "test-stream", Sequence: nats.SequencePair{Stream: 2}, NumDelivered: 3},
+	}).AnyTimes()
+	exhausted.EXPECT().DeadLetter("test-dlq", gomock.Any()).DoAndReturn(func(_ string, header nats.Header) error {
+		assert.Equal(t, id.String(), header.Get(jetbridge.DeadLetterBindingIDHeader))
+		assert.Equal(t, "3", header.Get(jetbridge.DeadLetterNumDeliveredHeader))
+		assert.Equal(t, "test-stream", header.Get(jetbridge.DeadLetterStreamHeader))
+		assert.Equal(t, "2", header.Get(jetbridge.DeadLetterStreamSequenceHeader))
+		assert.Equal(t, "test-stream.2", header.Get(jetbridge.DeadLetterSubjectHeader))
+		assert.Equal(t, `failed to run lambda: Unhandled: {"errorMessage":"order not found", "errorType":"Error"}`, header.Get(jetbridge.DeadLetterErrorHeader))
+		return nil
+	})
+	exhausted.EXPECT().Term().Return(nil)
+
+	candidate := &MessageHandler{
+		logger: zap.NewNop(),
+		lambda: &fakeLambda{
+			output: &lambda.InvokeOutput{
+				ExecutedVersion: aws.String("$LATEST"),
+				FunctionError:   aws.String("Unhandled"),
+				Payload:         []byte("{\"errorMessage\":\"order not found\",\r\n\"errorType\":\"Error\"}\n"),
+			},
+		},
+	}
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{retried})
+	assert.ErrorContains(t, err, "Unhandled")
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{exhausted})
+	assert.ErrorContains(t, err, "Unhandled")
+}
+
+func TestMessageHandler_HandleJetstreamMessages_retryPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:       id,
+		Target:   repositories.Target{Type: repositories.TargetLambda, URI: "test-arn"},
+		Stream:   "test-stream",
+		Consumer: id,
+		Subject:  "test-stream.*",
+		RetryPolicy: repositories.RetryPolicy{
+			InitialDelay: time.Second,
+			Multiplier:   2,
+			MaxDelay:     time.Minute,
+		},
+	}
+
+	msg := mocks.NewMockJetstreamMessage(ctrl)
+	msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{
+		Subject:  "test-stream.1",
+		Metadata: nats.MsgMetadata{Stream: "test-stream", Sequence: nats.SequencePair{Stream: 1}, NumDelivered: 3},
+	}).AnyTimes()
+	msg.EXPECT().NakWithDelay(4 * time.Second).Return(nil)
+
+	candidate := &MessageHandler{
+		logger: zap.NewNop(),
+		lambda: &fakeLambda{err: assert.AnError},
+	}
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{msg})
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestMessageHandler_HandleJetstreamMessages_invocationType(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:          id,
+		Target:      repositories.Target{Type: repositories.TargetLambda, URI: "test-arn"},
+		Stream:      "test-stream",
+		Consumer:    id,
+		Subject:     "test-stream.*",
+		MaxMessages: 2,
+		MaxLatency:  time.Second,
+	}
+
+	t.Run("event", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binding := binding
+		binding.InvocationType = repositories.InvocationEvent
+
+		first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+		first.EXPECT().Ack().Return(nil)
+		second.EXPECT().Ack().Return(nil)
+
+		fake := &fakeLambda{output: &lambda.InvokeOutput{StatusCode: aws.Int64(202)}}
+		candidate := &MessageHandler{logger: zap.NewNop(), lambda: fake}
+
+		err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+		require.NoError(t, err)
+		assert.Equal(t, "Event", aws.StringValue(fake.input.InvocationType))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binding := binding
+		binding.InvocationType = repositories.InvocationDryRun
+		binding.MaxDeliveries = 1
+
+		first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+		first.EXPECT().Ack().Return(nil)
+		second.EXPECT().Ack().Return(nil)
+
+		fake := &fakeLambda{output: &lambda.InvokeOutput{StatusCode: aws.Int64(204)}}
+		candidate := &MessageHandler{logger: zap.NewNop(), lambda: fake}
+
+		err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+		require.NoError(t, err)
+		assert.Equal(t, "DryRun", aws.StringValue(fake.input.InvocationType))
+	})
+
+	t.Run("dry run unbatched", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binding := binding
+		binding.InvocationType = repositories.InvocationDryRun
+		binding.MaxMessages = 0
+		binding.MaxLatency = 0
+
+		message := mocks.NewTestingJetstreamMessage(ctrl, 1)
+		message.EXPECT().Ack().Return(nil)
+
+		fake := &fakeLambda{output: &lambda.InvokeOutput{StatusCode: aws.Int64(204)}}
+		candidate := &MessageHandler{logger: zap.NewNop(), lambda: fake}
+
+		err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{message})
+		require.NoError(t, err)
+		assert.Equal(t, "DryRun", aws.StringValue(fake.input.InvocationType))
+	})
+}
+
+func TestMessageHandler_HandleJetstreamMessages_qualifier(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:       id,
+		Target:   repositories.Target{Type: repositories.TargetLambda, URI: "test-arn", Qualifier: "live"},
+		Stream:   "test-stream",
+		Consumer: id,
+		Subject:  "test-stream.*",
+	}
+
+	message := mocks.NewTestingJetstreamMessage(ctrl, 1)
+	message.EXPECT().Ack().Return(nil)
+
+	fake := &fakeLambda{output: &lambda.InvokeOutput{ExecutedVersion: aws.String("3")}}
+	candidate := &MessageHandler{logger: zap.NewNop(), lambda: fake}
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{message})
+	require.NoError(t, err)
+	assert.Equal(t, "test-arn", aws.StringValue(fake.input.FunctionName))
+	assert.Equal(t, "live", aws.StringValue(fake.input.Qualifier))
+}
+
+func TestMessageHandler_HandleJetstreamMessages_rawPayload(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:            id,
+		Target:        repositories.Target{Type: repositories.TargetLambda, URI: "test-arn"},
+		Stream:        "test-stream",
+		Consumer:      id,
+		Subject:       "test-stream.*",
+		PayloadFormat: repositories.PayloadFormatRaw,
+	}
+
+	message := mocks.NewMockJetstreamMessage(ctrl)
+	message.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{
+		Subject: "test-stream.1",
+		Data:    []byte(`{"id":42}`),
+	}).AnyTimes()
+	message.EXPECT().Ack().Return(nil)
+
+	fake := &fakeLambda{output: &lambda.InvokeOutput{}}
+	candidate := &MessageHandler{logger: zap.NewNop(), lambda: fake}
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{message})
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"id":42}`), fake.input.Payload)
+
+	// Lambda would reject data that is not JSON however often it was retried
+	notJSON := mocks.NewTestingJetstreamMessage(ctrl, 2)
+	notJSON.EXPECT().Term().Return(nil)
+
+	fake.input = nil
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{notJSON})
+	assert.ErrorContains(t, err, "not JSON")
+	assert.Nil(t, fake.input)
+}
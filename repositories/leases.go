@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_leases.go -package=mocks . Leases
+
+var (
+	// ErrLeaseHeld is returned when acquiring a lease that another peer holds.
+	ErrLeaseHeld = errors.New("lease is held by another peer")
+
+	// ErrLeaseLost is returned when renewing or releasing a lease that has
+	// since been acquired by another peer, or recording stats under it.
+	ErrLeaseLost = errors.New("lease is no longer held")
+)
+
+// Leases grants peers exclusive ownership of bindings. A peer must hold the
+// lease on a binding to run it, and must stop running it if the lease cannot
+// be renewed before it expires.
+//
+// Implementations of this interface must be safe for concurrent use by
+// multiple peers, such that at most one peer holds a binding's lease at a time.
+type Leases interface {
+	// AcquireLease acquires the lease on a binding for a peer, unless another
+	// peer holds an unexpired lease on it. Each acquisition is given a new
+	// fencing token, greater than that of any previous holder.
+	AcquireLease(ctx context.Context, bindingID, peerID uuid.UUID, ttl time.Duration) (*Lease, error)
+
+	// RenewLease extends a lease, provided no other peer has acquired it since.
+	RenewLease(ctx context.Context, lease Lease, ttl time.Duration) (*Lease, error)
+
+	// ReleaseLease expires a lease, so that another peer may acquire it
+	// without waiting for it to expire.
+	ReleaseLease(ctx context.Context, lease Lease) error
+}
+
+type Lease struct {
+	BindingID uuid.UUID
+	PeerID    uuid.UUID
+
+	// Token is the fencing token of the lease, which increases each time the
+	// lease is acquired. It identifies the holder across renewals.
+	Token     int64
+	ExpiresAt time.Time
+}
@@ -0,0 +1,130 @@
+// Package memory implements the repositories in memory, for tests and for
+// running a single peer without an external state store. State is lost when
+// the process exits, and is not shared with other peers.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
+)
+
+var _ repositories.Bindings = (*Bindings)(nil)
+
+type Bindings struct {
+	mu       sync.Mutex
+	bindings map[uuid.UUID]jetstreamBindingRecord
+	peers    *Peers
+}
+
+type jetstreamBindingRecord struct {
+	binding   repositories.JetstreamBinding
+	createdAt time.Time
+}
+
+func (b *Bindings) CreateJetstreamBinding(ctx context.Context, create *repositories.CreateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	id := uuid.New()
+
+	binding := create.Binding()
+	binding.ID = id
+	binding.Consumer = id
+
+	record := jetstreamBindingRecord{
+		binding:   binding,
+		createdAt: time.Now(),
+	}
+
+	b.mu.Lock()
+	b.bindings[id] = record
+	b.mu.Unlock()
+
+	return b.toJetstreamBinding(ctx, record.binding)
+}
+
+func (b *Bindings) GetJetstreamBinding(ctx context.Context, id uuid.UUID) (*repositories.JetstreamBinding, error) {
+	b.mu.Lock()
+	record, ok := b.bindings[id]
+	b.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+	}
+
+	return b.toJetstreamBinding(ctx, record.binding)
+}
+
+func (b *Bindings) ListJetstreamBindings(ctx context.Context) ([]repositories.JetstreamBinding, error) {
+	b.mu.Lock()
+	records := maps.Values(b.bindings)
+	b.mu.Unlock()
+
+	sort.Slice(records, func(i, j int) bool { return records[i].createdAt.Before(records[j].createdAt) })
+
+	bindings := make([]repositories.JetstreamBinding, len(records))
+	for i, record := range records {
+		binding, err := b.toJetstreamBinding(ctx, record.binding)
+		if err != nil {
+			return nil, err
+		}
+
+		bindings[i] = *binding
+	}
+
+	return bindings, nil
+}
+
+func (b *Bindings) UpdateJetstreamBinding(ctx context.Context, id uuid.UUID, update *repositories.UpdateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	b.mu.Lock()
+	record, ok := b.bindings[id]
+	if !ok {
+		b.mu.Unlock()
+		return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+	}
+
+	if err := record.binding.ValidateUpdate(update); err != nil {
+		b.mu.Unlock()
+		return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, err)
+	}
+
+	record.binding.Apply(update)
+
+	b.bindings[id] = record
+	b.mu.Unlock()
+
+	return b.toJetstreamBinding(ctx, record.binding)
+}
+
+func (b *Bindings) DeleteJetstreamBinding(ctx context.Context, id uuid.UUID) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.bindings, id)
+	return nil
+}
+
+// toJetstreamBinding returns a copy of the stored binding, assigned to one of
+// the current peers.
+func (b *Bindings) toJetstreamBinding(ctx context.Context, binding repositories.JetstreamBinding) (*repositories.JetstreamBinding, error) {
+	peers, err := b.peers.ListPeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	binding.RequiredLabels = maps.Clone(binding.RequiredLabels)
+	binding.AssignedPeerID = repositories.AssignPeer(binding, peers)
+
+	return &binding, nil
+}
+
+func NewBindings(peers *Peers) *Bindings {
+	return &Bindings{
+		bindings: make(map[uuid.UUID]jetstreamBindingRecord),
+		peers:    peers,
+	}
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBindingsConformance(t *testing.T) {
+	peers := NewPeers()
+
+	suite.Run(t, conformancetest.NewBindingsConformanceSuite(peers, NewBindings(peers)))
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.Leases = (*Leases)(nil)
+
+type Leases struct {
+	mu     sync.Mutex
+	leases map[uuid.UUID]repositories.Lease
+}
+
+func (l *Leases) AcquireLease(ctx context.Context, bindingID, peerID uuid.UUID, ttl time.Duration) (*repositories.Lease, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	lease, ok := l.leases[bindingID]
+	if ok && lease.PeerID != peerID && lease.ExpiresAt.After(now) {
+		return nil, repositories.ErrLeaseHeld
+	}
+
+	lease = repositories.Lease{
+		BindingID: bindingID,
+		PeerID:    peerID,
+		Token:     lease.Token + 1,
+		ExpiresAt: now.Add(ttl),
+	}
+	l.leases[bindingID] = lease
+
+	return &lease, nil
+}
+
+func (l *Leases) RenewLease(ctx context.Context, lease repositories.Lease, ttl time.Duration) (*repositories.Lease, error) {
+	return l.update(lease, time.Now().Add(ttl))
+}
+
+func (l *Leases) ReleaseLease(ctx context.Context, lease repositories.Lease) error {
+	// The lease is kept, rather than deleted, so that fencing tokens keep
+	// increasing for the next holder
+	_, err := l.update(lease, time.Now())
+	return err
+}
+
+// update sets the expiry of a lease, provided it is still held.
+func (l *Leases) update(lease repositories.Lease, expiresAt time.Time) (*repositories.Lease, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	current, ok := l.leases[lease.BindingID]
+	if !ok || current.PeerID != lease.PeerID || current.Token != lease.Token {
+		return nil, repositories.ErrLeaseLost
+	}
+
+	current.ExpiresAt = expiresAt
+	l.leases[lease.BindingID] = current
+
+	return &current, nil
+}
+
+func NewLeases() *Leases {
+	return &Leases{
+		leases: make(map[uuid.UUID]repositories.Lease),
+	}
+}
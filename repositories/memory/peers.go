@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
+)
+
+var _ repositories.Peers = (*Peers)(nil)
+
+type Peers struct {
+	mu      sync.Mutex
+	peers   map[uuid.UUID]repositories.Peer
+	peerTTL time.Duration
+}
+
+func (s *Peers) JoinPeers(ctx context.Context, join *repositories.JoinPeer) (*repositories.Peer, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	peer := repositories.Peer{
+		ID:             uuid.New(),
+		Hostname:       hostname,
+		JoinedAt:       time.Now(),
+		LastSeenAt:     time.Now(),
+		HeartbeatDueBy: time.Now().Add(s.peerTTL),
+		Weight:         join.Weight,
+		Labels:         maps.Clone(join.Labels),
+	}
+	s.peers[peer.ID] = peer
+
+	return copyPeer(peer), nil
+}
+
+func (s *Peers) SendHeartbeat(ctx context.Context, id uuid.UUID) (*repositories.Peer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	peer, ok := s.peers[id]
+	if !ok {
+		return nil, fmt.Errorf("peer %s not found", id)
+	}
+
+	peer.LastSeenAt = time.Now()
+	peer.HeartbeatDueBy = time.Now().Add(s.peerTTL)
+	s.peers[id] = peer
+
+	return copyPeer(peer), nil
+}
+
+func (s *Peers) LeavePeers(ctx context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.peers[id]; !ok {
+		return fmt.Errorf("peer %s not found", id)
+	}
+
+	delete(s.peers, id)
+	return nil
+}
+
+func (s *Peers) ListPeers(ctx context.Context) ([]repositories.Peer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var peers []repositories.Peer
+	for id, peer := range s.peers {
+		// Expire peers that have missed their heartbeat
+		if !peer.HeartbeatDueBy.After(time.Now()) {
+			delete(s.peers, id)
+			continue
+		}
+
+		peers = append(peers, *copyPeer(peer))
+	}
+
+	return peers, nil
+}
+
+func NewPeers() *Peers {
+	return &Peers{
+		peers:   make(map[uuid.UUID]repositories.Peer),
+		peerTTL: 5 * time.Second,
+	}
+}
+
+func copyPeer(peer repositories.Peer) *repositories.Peer {
+	peer.Labels = maps.Clone(peer.Labels)
+	return &peer
+}
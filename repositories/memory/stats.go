@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.Stats = (*Stats)(nil)
+
+type Stats struct {
+	mu    sync.Mutex
+	stats map[uuid.UUID]repositories.BindingStats
+}
+
+func (s *Stats) RecordBindingStats(ctx context.Context, stats *repositories.BindingStats) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := s.stats[stats.BindingID]
+	if stats.LeaseToken < record.LeaseToken {
+		return repositories.ErrLeaseLost
+	}
+
+	record.BindingID = stats.BindingID
+	record.PeerID = stats.PeerID
+	record.LeaseToken = stats.LeaseToken
+	record.RecordedAt = stats.RecordedAt
+	record.RunningUntil = stats.RunningUntil
+
+	if !stats.LastSuccessAt.IsZero() {
+		record.LastSuccessAt = stats.LastSuccessAt
+	}
+
+	if !stats.LastErrorAt.IsZero() {
+		record.LastErrorAt = stats.LastErrorAt
+		record.LastError = stats.LastError
+	}
+
+	s.stats[stats.BindingID] = record
+	return nil
+}
+
+func (s *Stats) GetBindingStats(ctx context.Context, bindingID uuid.UUID) (*repositories.BindingStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.stats[bindingID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &stats, nil
+}
+
+func NewStats() *Stats {
+	return &Stats{
+		stats: make(map[uuid.UUID]repositories.BindingStats),
+	}
+}
@@ -0,0 +1,12 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStatsConformance(t *testing.T) {
+	suite.Run(t, conformancetest.NewStatsConformanceSuite(NewStats()))
+}
@@ -2,12 +2,14 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"fmt"
 )
 
 //go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_messagehandler.go -package=mocks . MessageHandler
 
 // MessageHandler defines the interface for a repository that can
-// execute lambdas for jetstream messages.
+// deliver jetstream messages to a binding's target.
 //
 // Implementations of this interface should fully handle the lifecycle of the
 // message, including ACK-ing and NACK-ing the message as appropriate.
@@ -20,3 +22,30 @@ import (
 type MessageHandler interface {
 	HandleJetstreamMessages(ctx context.Context, binding JetstreamBinding, messages []JetstreamMessage) error
 }
+
+var _ MessageHandler = MessageHandlers(nil)
+
+// MessageHandlers routes each binding to the MessageHandler for its target
+// type, so that one fleet can serve bindings of every type. Messages of
+// bindings with no handler for their target type are NAK'd.
+type MessageHandlers map[TargetType]MessageHandler
+
+func (h MessageHandlers) HandleJetstreamMessages(ctx context.Context, binding JetstreamBinding, messages []JetstreamMessage) error {
+	targetType := binding.Target.Type
+	if targetType == "" {
+		targetType = TargetLambda
+	}
+
+	if handler, ok := h[targetType]; ok {
+		return handler.HandleJetstreamMessages(ctx, binding, messages)
+	}
+
+	errs := []error{fmt.Errorf("no handler for target type %q", targetType)}
+	for _, message := range messages {
+		if err := message.Nak(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,46 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageHandlers_HandleJetstreamMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lambda := mocks.NewMockMessageHandler(ctrl)
+	webhook := mocks.NewMockMessageHandler(ctrl)
+	handlers := repositories.MessageHandlers{
+		repositories.TargetLambda:  lambda,
+		repositories.TargetWebhook: webhook,
+	}
+
+	message := mocks.NewMockJetstreamMessage(ctrl)
+	messages := []repositories.JetstreamMessage{message}
+
+	t.Run("routes by target type", func(t *testing.T) {
+		binding := repositories.JetstreamBinding{Target: repositories.Target{Type: repositories.TargetWebhook, URI: "https://example.com"}}
+		webhook.EXPECT().HandleJetstreamMessages(gomock.Any(), binding, messages).Return(nil)
+
+		assert.NoError(t, handlers.HandleJetstreamMessages(context.Background(), binding, messages))
+	})
+
+	t.Run("defaults to lambda", func(t *testing.T) {
+		binding := repositories.JetstreamBinding{Target: repositories.Target{URI: "my-function"}}
+		lambda.EXPECT().HandleJetstreamMessages(gomock.Any(), binding, messages).Return(nil)
+
+		assert.NoError(t, handlers.HandleJetstreamMessages(context.Background(), binding, messages))
+	})
+
+	t.Run("naks messages without a handler", func(t *testing.T) {
+		binding := repositories.JetstreamBinding{Target: repositories.Target{Type: "sqs", URI: "queue"}}
+		message.EXPECT().Nak().Return(nil)
+
+		assert.ErrorContains(t, handlers.HandleJetstreamMessages(context.Background(), binding, messages), `no handler for target type "sqs"`)
+	})
+}
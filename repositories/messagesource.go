@@ -8,4 +8,9 @@ import (
 
 type MessageSource interface {
 	FetchJetstreamMessages(ctx context.Context, binding JetstreamBinding) ([]JetstreamMessage, error)
+
+	// CloseJetstreamBinding releases any subscriptions held for the binding,
+	// once the caller has stopped fetching its messages. The consumer itself
+	// is left in place.
+	CloseJetstreamBinding(binding JetstreamBinding)
 }
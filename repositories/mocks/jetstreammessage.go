@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"github.com/JoeReid/jetbridge"
+	"github.com/golang/mock/gomock"
+	"github.com/nats-io/nats.go"
+)
+
+// NewTestingJetstreamMessage returns a mock message, delivered once, with the
+// given stream sequence. Its payload may be read any number of times, but how
+// it is settled must be expected by the test.
+func NewTestingJetstreamMessage(ctrl *gomock.Controller, sequence uint64) *MockJetstreamMessage {
+	msg := NewMockJetstreamMessage(ctrl)
+	msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{
+		Subject: "test-stream.1",
+		Data:    []byte("test"),
+		Metadata: nats.MsgMetadata{
+			Stream:       "test-stream",
+			Sequence:     nats.SequencePair{Stream: sequence},
+			NumDelivered: 1,
+		},
+	}).AnyTimes()
+
+	return msg
+}
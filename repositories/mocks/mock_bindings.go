@@ -94,3 +94,18 @@ func (mr *MockBindingsMockRecorder) ListJetstreamBindings(arg0 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJetstreamBindings", reflect.TypeOf((*MockBindings)(nil).ListJetstreamBindings), arg0)
 }
+
+// UpdateJetstreamBinding mocks base method.
+func (m *MockBindings) UpdateJetstreamBinding(arg0 context.Context, arg1 uuid.UUID, arg2 *repositories.UpdateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJetstreamBinding", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*repositories.JetstreamBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJetstreamBinding indicates an expected call of UpdateJetstreamBinding.
+func (mr *MockBindingsMockRecorder) UpdateJetstreamBinding(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJetstreamBinding", reflect.TypeOf((*MockBindings)(nil).UpdateJetstreamBinding), arg0, arg1, arg2)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/JoeReid/jetbridge/repositories (interfaces: Consumers)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repositories "github.com/JoeReid/jetbridge/repositories"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockConsumers is a mock of Consumers interface.
+type MockConsumers struct {
+	ctrl     *gomock.Controller
+	recorder *MockConsumersMockRecorder
+}
+
+// MockConsumersMockRecorder is the mock recorder for MockConsumers.
+type MockConsumersMockRecorder struct {
+	mock *MockConsumers
+}
+
+// NewMockConsumers creates a new mock instance.
+func NewMockConsumers(ctrl *gomock.Controller) *MockConsumers {
+	mock := &MockConsumers{ctrl: ctrl}
+	mock.recorder = &MockConsumersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConsumers) EXPECT() *MockConsumersMockRecorder {
+	return m.recorder
+}
+
+// DeleteConsumer mocks base method.
+func (m *MockConsumers) DeleteConsumer(arg0 context.Context, arg1 repositories.Consumer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConsumer indicates an expected call of DeleteConsumer.
+func (mr *MockConsumersMockRecorder) DeleteConsumer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumer", reflect.TypeOf((*MockConsumers)(nil).DeleteConsumer), arg0, arg1)
+}
+
+// GetConsumerStatus mocks base method.
+func (m *MockConsumers) GetConsumerStatus(arg0 context.Context, arg1 repositories.JetstreamBinding) (*repositories.ConsumerStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerStatus", arg0, arg1)
+	ret0, _ := ret[0].(*repositories.ConsumerStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerStatus indicates an expected call of GetConsumerStatus.
+func (mr *MockConsumersMockRecorder) GetConsumerStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerStatus", reflect.TypeOf((*MockConsumers)(nil).GetConsumerStatus), arg0, arg1)
+}
+
+// ListConsumers mocks base method.
+func (m *MockConsumers) ListConsumers(arg0 context.Context) ([]repositories.Consumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumers", arg0)
+	ret0, _ := ret[0].([]repositories.Consumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumers indicates an expected call of ListConsumers.
+func (mr *MockConsumersMockRecorder) ListConsumers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumers", reflect.TypeOf((*MockConsumers)(nil).ListConsumers), arg0)
+}
@@ -6,9 +6,11 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	jetbridge "github.com/JoeReid/jetbridge"
 	gomock "github.com/golang/mock/gomock"
+	nats "github.com/nats-io/nats.go"
 )
 
 // MockJetstreamMessage is a mock of JetstreamMessage interface.
@@ -48,6 +50,20 @@ func (mr *MockJetstreamMessageMockRecorder) Ack() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ack", reflect.TypeOf((*MockJetstreamMessage)(nil).Ack))
 }
 
+// DeadLetter mocks base method.
+func (m *MockJetstreamMessage) DeadLetter(arg0 string, arg1 nats.Header) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockJetstreamMessageMockRecorder) DeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockJetstreamMessage)(nil).DeadLetter), arg0, arg1)
+}
+
 // Nak mocks base method.
 func (m *MockJetstreamMessage) Nak() error {
 	m.ctrl.T.Helper()
@@ -62,6 +78,20 @@ func (mr *MockJetstreamMessageMockRecorder) Nak() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nak", reflect.TypeOf((*MockJetstreamMessage)(nil).Nak))
 }
 
+// NakWithDelay mocks base method.
+func (m *MockJetstreamMessage) NakWithDelay(arg0 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NakWithDelay", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NakWithDelay indicates an expected call of NakWithDelay.
+func (mr *MockJetstreamMessageMockRecorder) NakWithDelay(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NakWithDelay", reflect.TypeOf((*MockJetstreamMessage)(nil).NakWithDelay), arg0)
+}
+
 // Payload mocks base method.
 func (m *MockJetstreamMessage) Payload() jetbridge.JetstreamLambdaPayload {
 	m.ctrl.T.Helper()
@@ -75,3 +105,17 @@ func (mr *MockJetstreamMessageMockRecorder) Payload() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Payload", reflect.TypeOf((*MockJetstreamMessage)(nil).Payload))
 }
+
+// Term mocks base method.
+func (m *MockJetstreamMessage) Term() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Term")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Term indicates an expected call of Term.
+func (mr *MockJetstreamMessageMockRecorder) Term() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Term", reflect.TypeOf((*MockJetstreamMessage)(nil).Term))
+}
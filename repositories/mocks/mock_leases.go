@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/JoeReid/jetbridge/repositories (interfaces: Leases)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	repositories "github.com/JoeReid/jetbridge/repositories"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeases is a mock of Leases interface.
+type MockLeases struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeasesMockRecorder
+}
+
+// MockLeasesMockRecorder is the mock recorder for MockLeases.
+type MockLeasesMockRecorder struct {
+	mock *MockLeases
+}
+
+// NewMockLeases creates a new mock instance.
+func NewMockLeases(ctrl *gomock.Controller) *MockLeases {
+	mock := &MockLeases{ctrl: ctrl}
+	mock.recorder = &MockLeasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeases) EXPECT() *MockLeasesMockRecorder {
+	return m.recorder
+}
+
+// AcquireLease mocks base method.
+func (m *MockLeases) AcquireLease(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 time.Duration) (*repositories.Lease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireLease", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*repositories.Lease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireLease indicates an expected call of AcquireLease.
+func (mr *MockLeasesMockRecorder) AcquireLease(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLease", reflect.TypeOf((*MockLeases)(nil).AcquireLease), arg0, arg1, arg2, arg3)
+}
+
+// ReleaseLease mocks base method.
+func (m *MockLeases) ReleaseLease(arg0 context.Context, arg1 repositories.Lease) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLease", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLease indicates an expected call of ReleaseLease.
+func (mr *MockLeasesMockRecorder) ReleaseLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLease", reflect.TypeOf((*MockLeases)(nil).ReleaseLease), arg0, arg1)
+}
+
+// RenewLease mocks base method.
+func (m *MockLeases) RenewLease(arg0 context.Context, arg1 repositories.Lease, arg2 time.Duration) (*repositories.Lease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewLease", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*repositories.Lease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewLease indicates an expected call of RenewLease.
+func (mr *MockLeasesMockRecorder) RenewLease(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLease", reflect.TypeOf((*MockLeases)(nil).RenewLease), arg0, arg1, arg2)
+}
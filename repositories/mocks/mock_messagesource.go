@@ -35,6 +35,18 @@ func (m *MockMessageSource) EXPECT() *MockMessageSourceMockRecorder {
 	return m.recorder
 }
 
+// CloseJetstreamBinding mocks base method.
+func (m *MockMessageSource) CloseJetstreamBinding(arg0 repositories.JetstreamBinding) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CloseJetstreamBinding", arg0)
+}
+
+// CloseJetstreamBinding indicates an expected call of CloseJetstreamBinding.
+func (mr *MockMessageSourceMockRecorder) CloseJetstreamBinding(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseJetstreamBinding", reflect.TypeOf((*MockMessageSource)(nil).CloseJetstreamBinding), arg0)
+}
+
 // FetchJetstreamMessages mocks base method.
 func (m *MockMessageSource) FetchJetstreamMessages(arg0 context.Context, arg1 repositories.JetstreamBinding) ([]repositories.JetstreamMessage, error) {
 	m.ctrl.T.Helper()
@@ -37,18 +37,18 @@ func (m *MockPeers) EXPECT() *MockPeersMockRecorder {
 }
 
 // JoinPeers mocks base method.
-func (m *MockPeers) JoinPeers(arg0 context.Context) (*repositories.Peer, error) {
+func (m *MockPeers) JoinPeers(arg0 context.Context, arg1 *repositories.JoinPeer) (*repositories.Peer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "JoinPeers", arg0)
+	ret := m.ctrl.Call(m, "JoinPeers", arg0, arg1)
 	ret0, _ := ret[0].(*repositories.Peer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // JoinPeers indicates an expected call of JoinPeers.
-func (mr *MockPeersMockRecorder) JoinPeers(arg0 interface{}) *gomock.Call {
+func (mr *MockPeersMockRecorder) JoinPeers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinPeers", reflect.TypeOf((*MockPeers)(nil).JoinPeers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinPeers", reflect.TypeOf((*MockPeers)(nil).JoinPeers), arg0, arg1)
 }
 
 // LeavePeers mocks base method.
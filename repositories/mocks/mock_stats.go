@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/JoeReid/jetbridge/repositories (interfaces: Stats)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repositories "github.com/JoeReid/jetbridge/repositories"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockStats is a mock of Stats interface.
+type MockStats struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatsMockRecorder
+}
+
+// MockStatsMockRecorder is the mock recorder for MockStats.
+type MockStatsMockRecorder struct {
+	mock *MockStats
+}
+
+// NewMockStats creates a new mock instance.
+func NewMockStats(ctrl *gomock.Controller) *MockStats {
+	mock := &MockStats{ctrl: ctrl}
+	mock.recorder = &MockStatsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStats) EXPECT() *MockStatsMockRecorder {
+	return m.recorder
+}
+
+// GetBindingStats mocks base method.
+func (m *MockStats) GetBindingStats(arg0 context.Context, arg1 uuid.UUID) (*repositories.BindingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBindingStats", arg0, arg1)
+	ret0, _ := ret[0].(*repositories.BindingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBindingStats indicates an expected call of GetBindingStats.
+func (mr *MockStatsMockRecorder) GetBindingStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBindingStats", reflect.TypeOf((*MockStats)(nil).GetBindingStats), arg0, arg1)
+}
+
+// RecordBindingStats mocks base method.
+func (m *MockStats) RecordBindingStats(arg0 context.Context, arg1 *repositories.BindingStats) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordBindingStats", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordBindingStats indicates an expected call of RecordBindingStats.
+func (mr *MockStatsMockRecorder) RecordBindingStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBindingStats", reflect.TypeOf((*MockStats)(nil).RecordBindingStats), arg0, arg1)
+}
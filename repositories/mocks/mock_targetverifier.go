@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/JoeReid/jetbridge/repositories (interfaces: TargetVerifier)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repositories "github.com/JoeReid/jetbridge/repositories"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTargetVerifier is a mock of TargetVerifier interface.
+type MockTargetVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTargetVerifierMockRecorder
+}
+
+// MockTargetVerifierMockRecorder is the mock recorder for MockTargetVerifier.
+type MockTargetVerifierMockRecorder struct {
+	mock *MockTargetVerifier
+}
+
+// NewMockTargetVerifier creates a new mock instance.
+func NewMockTargetVerifier(ctrl *gomock.Controller) *MockTargetVerifier {
+	mock := &MockTargetVerifier{ctrl: ctrl}
+	mock.recorder = &MockTargetVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTargetVerifier) EXPECT() *MockTargetVerifierMockRecorder {
+	return m.recorder
+}
+
+// VerifyTarget mocks base method.
+func (m *MockTargetVerifier) VerifyTarget(arg0 context.Context, arg1 repositories.Target) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTarget", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyTarget indicates an expected call of VerifyTarget.
+func (mr *MockTargetVerifierMockRecorder) VerifyTarget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTarget", reflect.TypeOf((*MockTargetVerifier)(nil).VerifyTarget), arg0, arg1)
+}
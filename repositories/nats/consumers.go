@@ -0,0 +1,108 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+var _ repositories.Consumers = (*Consumers)(nil)
+
+// consumerDescriptionPrefix marks the consumers created by JetBridge, so that
+// those left behind by deleted bindings can be found and removed. It is
+// followed by the deployment that created the consumer, so that deployments
+// sharing a NATS account never remove each other's consumers.
+const consumerDescriptionPrefix = "JetBridge consumer of "
+
+// consumerDescription describes a consumer created by the deployment for the
+// binding. It names the binding rather than its target, as anyone able to read
+// consumer info can read the description, and target URLs may hold secrets.
+func consumerDescription(deployment string, bindingID uuid.UUID) string {
+	return consumerDeploymentPrefix(deployment) + "binding " + bindingID.String()
+}
+
+func consumerDeploymentPrefix(deployment string) string {
+	return consumerDescriptionPrefix + deployment + " for "
+}
+
+// NewConsumers returns the consumers created by the deployment, which must be
+// unique among the deployments of JetBridge sharing the NATS account, such as
+// the name of the state store.
+func NewConsumers(js nats.JetStreamContext, deployment string) (*Consumers, error) {
+	if deployment == "" {
+		return nil, errors.New("deployment must be set")
+	}
+
+	return &Consumers{js: js, deployment: deployment}, nil
+}
+
+type Consumers struct {
+	js         nats.JetStreamContext
+	deployment string
+}
+
+func (c *Consumers) GetConsumerStatus(ctx context.Context, binding repositories.JetstreamBinding) (*repositories.ConsumerStatus, error) {
+	info, err := c.js.ConsumerInfo(binding.Stream, binding.Consumer.String(), nats.Context(ctx))
+	switch {
+	case errors.Is(err, nats.ErrConsumerNotFound), errors.Is(err, nats.ErrStreamNotFound):
+		return nil, repositories.ErrConsumerNotFound
+
+	case err != nil:
+		return nil, fmt.Errorf("failed to get consumer info: %w", err)
+	}
+
+	return &repositories.ConsumerStatus{
+		NumPending:                    info.NumPending,
+		NumAckPending:                 info.NumAckPending,
+		NumRedelivered:                info.NumRedelivered,
+		LastDeliveredStreamSequence:   info.Delivered.Stream,
+		LastDeliveredConsumerSequence: info.Delivered.Consumer,
+	}, nil
+}
+
+func (c *Consumers) ListConsumers(ctx context.Context) ([]repositories.Consumer, error) {
+	prefix := consumerDeploymentPrefix(c.deployment)
+
+	var consumers []repositories.Consumer
+	for stream := range c.js.StreamNames(nats.Context(ctx)) {
+		for info := range c.js.Consumers(stream, nats.Context(ctx)) {
+			if !strings.HasPrefix(info.Config.Description, prefix) {
+				continue
+			}
+
+			name, err := uuid.Parse(info.Name)
+			if err != nil {
+				continue
+			}
+
+			consumers = append(consumers, repositories.Consumer{Stream: stream, Name: name})
+		}
+	}
+
+	// The listers only report errors by closing early, so the list may be
+	// incomplete. That is safe for a sweep, which removes the rest next time,
+	// but a cancelled context means nothing was listed at all.
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list consumers: %w", err)
+	}
+
+	return consumers, nil
+}
+
+func (c *Consumers) DeleteConsumer(ctx context.Context, consumer repositories.Consumer) error {
+	err := c.js.DeleteConsumer(consumer.Stream, consumer.Name.String(), nats.Context(ctx))
+	switch {
+	case errors.Is(err, nats.ErrConsumerNotFound), errors.Is(err, nats.ErrStreamNotFound):
+		return repositories.ErrConsumerNotFound
+
+	case err != nil:
+		return fmt.Errorf("failed to delete consumer: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package nats
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestConsumers_GetConsumerStatus(t *testing.T) {
+	js := testingNATS(t)
+
+	_, err := js.AddStream(&nats.StreamConfig{
+		Name:     "TESTSTREAM",
+		Subjects: []string{"TESTSTREAM.*"},
+	}, nats.MaxWait(5*time.Second))
+	require.NoError(t, err)
+
+	for _, subject := range []string{"TESTSTREAM.1", "TESTSTREAM.2", "TESTSTREAM.3"} {
+		_, err = js.Publish(subject, []byte("test message"))
+		require.NoError(t, err)
+	}
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:          id,
+		Target:      repositories.Target{URI: "test-arn"},
+		Stream:      "TESTSTREAM",
+		Consumer:    id,
+		Subject:     "TESTSTREAM.*",
+		MaxMessages: 2,
+		MaxLatency:  time.Second,
+	}
+
+	candidate, err := NewConsumers(js, "test")
+	require.NoError(t, err)
+
+	t.Run("consumer not exist", func(t *testing.T) {
+		_, err := candidate.GetConsumerStatus(context.TODO(), binding)
+		assert.ErrorIs(t, err, repositories.ErrConsumerNotFound)
+	})
+
+	t.Run("consumer exists", func(t *testing.T) {
+		source := &MessageSource{
+			logger:        zap.NewNop(),
+			js:            js,
+			deployment:    "test",
+			mu:            &sync.Mutex{},
+			subscriptions: make(map[string]*subscription),
+		}
+
+		msgs, err := source.FetchJetstreamMessages(context.TODO(), binding)
+		require.NoError(t, err)
+		require.Len(t, msgs, 2)
+		require.NoError(t, msgs[0].Ack())
+
+		status, err := candidate.GetConsumerStatus(context.TODO(), binding)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(1), status.NumPending)
+		assert.Equal(t, 1, status.NumAckPending)
+		assert.Equal(t, 0, status.NumRedelivered)
+		assert.Equal(t, uint64(2), status.LastDeliveredStreamSequence)
+		assert.Equal(t, uint64(2), status.LastDeliveredConsumerSequence)
+	})
+
+	t.Run("list consumers", func(t *testing.T) {
+		_, err := js.AddConsumer("TESTSTREAM", &nats.ConsumerConfig{
+			Durable:     "not-jetbridge",
+			Description: "Some other consumer",
+			AckPolicy:   nats.AckExplicitPolicy,
+		})
+		require.NoError(t, err)
+
+		_, err = js.AddConsumer("TESTSTREAM", &nats.ConsumerConfig{
+			Durable:     uuid.NewString(),
+			Description: consumerDescription("other", uuid.New()),
+			AckPolicy:   nats.AckExplicitPolicy,
+		})
+		require.NoError(t, err)
+
+		consumers, err := candidate.ListConsumers(context.TODO())
+		require.NoError(t, err)
+
+		assert.Equal(t, []repositories.Consumer{{Stream: "TESTSTREAM", Name: id}}, consumers)
+	})
+
+	t.Run("delete consumer", func(t *testing.T) {
+		consumer := repositories.Consumer{Stream: "TESTSTREAM", Name: id}
+
+		err := candidate.DeleteConsumer(context.TODO(), consumer)
+		require.NoError(t, err)
+
+		_, err = candidate.GetConsumerStatus(context.TODO(), binding)
+		assert.ErrorIs(t, err, repositories.ErrConsumerNotFound)
+
+		err = candidate.DeleteConsumer(context.TODO(), consumer)
+		assert.ErrorIs(t, err, repositories.ErrConsumerNotFound)
+	})
+}
@@ -0,0 +1,127 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/metrics"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/settle"
+	"github.com/JoeReid/jetbridge/tracing"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// DefaultRequestTimeout bounds each request if no timeout is given.
+const DefaultRequestTimeout = 30 * time.Second
+
+var _ repositories.MessageHandler = (*MessageHandler)(nil)
+
+// requester is the subset of *nats.Conn used to make requests.
+type requester interface {
+	RequestMsgWithContext(ctx context.Context, msg *nats.Msg) (*nats.Msg, error)
+}
+
+// MessageHandler sends the messages of a binding with a NATS target as a
+// request to the target subject, such as that of a NATS service, encoded in
+// the binding's payload format.
+//
+// Messages are ACK'd once a reply is received. A batch may report individual
+// failures with a jetbridge.JetstreamBatchedLambdaResponse reply, as a Lambda
+// would. Timeouts, a lack of responders and replies carrying the
+// Nats-Service-Error header of a NATS service error are retried according to
+// the binding's retry policy.
+type MessageHandler struct {
+	logger  *zap.Logger
+	nc      requester
+	timeout time.Duration
+}
+
+func (m *MessageHandler) HandleJetstreamMessages(ctx context.Context, binding repositories.JetstreamBinding, messages []repositories.JetstreamMessage) error {
+	return settle.Dispatch(ctx, m.bindingLogger(binding), binding, messages, func(ctx context.Context, payload []byte, contentType string) ([]byte, error) {
+		out, err := m.request(ctx, binding, payload, contentType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request %s: %w", binding.Target.URI, err)
+		}
+
+		return out, nil
+	})
+}
+
+func (m *MessageHandler) request(ctx context.Context, binding repositories.JetstreamBinding, payload []byte, contentType string) (_ []byte, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "nats.request", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("jetbridge.binding_id", binding.ID.String()),
+		attribute.String("messaging.destination.name", binding.Target.URI),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	msg := nats.NewMsg(binding.Target.URI)
+	msg.Data = payload
+	msg.Header.Set("Content-Type", contentType)
+	msg.Header.Set(jetbridge.BindingIDHeader, binding.ID.String())
+
+	// Forward the trace context so the service can continue the trace
+	tracing.InjectNATS(ctx, msg.Header)
+
+	start := time.Now()
+	reply, err := m.nc.RequestMsgWithContext(ctx, msg)
+	metrics.NATSRequestDuration.WithLabelValues(binding.ID.String()).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		metrics.NATSRequestErrors.WithLabelValues(binding.ID.String()).Inc()
+		return nil, err
+	}
+
+	if description := reply.Header.Get(micro.ErrorHeader); description != "" {
+		metrics.NATSRequestErrors.WithLabelValues(binding.ID.String()).Inc()
+
+		code := reply.Header.Get(micro.ErrorCodeHeader)
+		m.bindingLogger(binding).Debug(
+			"service returned error",
+			zap.String("code", code),
+			zap.String("error", description),
+		)
+		return nil, fmt.Errorf("service returned error %s: %s", code, description)
+	}
+
+	m.bindingLogger(binding).Debug("request replied to successfully")
+
+	return reply.Data, nil
+}
+
+func NewMessageHandler(nc *nats.Conn, timeout time.Duration, logger *zap.Logger) (*MessageHandler, error) {
+	if timeout <= 0 {
+		timeout = DefaultRequestTimeout
+	}
+
+	return &MessageHandler{
+		logger:  logger.With(zap.String("component", "nats")),
+		nc:      nc,
+		timeout: timeout,
+	}, nil
+}
+
+// bindingLogger returns a logger annotated with the fields identifying a binding
+// and the subject it sends requests to.
+func (m *MessageHandler) bindingLogger(binding repositories.JetstreamBinding) *zap.Logger {
+	return m.logger.With(
+		zap.String("binding_id", binding.ID.String()),
+		zap.String("stream", binding.Stream),
+		zap.String("target_subject", binding.Target.URI),
+	)
+}
@@ -0,0 +1,167 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeRequester replies to each request with the result of reply.
+type fakeRequester struct {
+	requests []*nats.Msg
+	reply    func(ctx context.Context, msg *nats.Msg) (*nats.Msg, error)
+}
+
+func (f *fakeRequester) RequestMsgWithContext(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
+	f.requests = append(f.requests, msg)
+	return f.reply(ctx, msg)
+}
+
+func testingHandlerBinding() repositories.JetstreamBinding {
+	id := uuid.New()
+	return repositories.JetstreamBinding{
+		ID:       id,
+		Target:   repositories.Target{Type: repositories.TargetNATS, URI: "svc.orders"},
+		Stream:   "test-stream",
+		Consumer: id,
+		Subject:  "test-stream.*",
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_request(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	binding := testingHandlerBinding()
+
+	nc := &fakeRequester{reply: func(_ context.Context, msg *nats.Msg) (*nats.Msg, error) {
+		return &nats.Msg{Subject: msg.Reply}, nil
+	}}
+
+	candidate := &MessageHandler{logger: zap.NewNop(), nc: nc, timeout: time.Second}
+
+	first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+	first.EXPECT().Ack().Return(nil)
+	second.EXPECT().Ack().Return(nil)
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+	require.NoError(t, err)
+
+	require.Len(t, nc.requests, 2)
+	for i, request := range nc.requests {
+		assert.Equal(t, "svc.orders", request.Subject)
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		assert.Equal(t, binding.ID.String(), request.Header.Get(jetbridge.BindingIDHeader))
+
+		var payload jetbridge.JetstreamLambdaPayload
+		require.NoError(t, json.Unmarshal(request.Data, &payload))
+		assert.Equal(t, uint64(i+1), payload.Metadata.Sequence.Stream)
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_failure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		reply  func(ctx context.Context, msg *nats.Msg) (*nats.Msg, error)
+		expect string
+	}{
+		{
+			name: "service error",
+			reply: func(_ context.Context, msg *nats.Msg) (*nats.Msg, error) {
+				reply := nats.NewMsg(msg.Reply)
+				reply.Header.Set(micro.ErrorHeader, "database unavailable")
+				reply.Header.Set(micro.ErrorCodeHeader, "503")
+				return reply, nil
+			},
+			expect: "service returned error 503: database unavailable",
+		},
+		{
+			name: "no responders",
+			reply: func(context.Context, *nats.Msg) (*nats.Msg, error) {
+				return nil, nats.ErrNoResponders
+			},
+			expect: nats.ErrNoResponders.Error(),
+		},
+		{
+			name: "timeout",
+			reply: func(ctx context.Context, _ *nats.Msg) (*nats.Msg, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			expect: context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			candidate := &MessageHandler{
+				logger:  zap.NewNop(),
+				nc:      &fakeRequester{reply: tt.reply},
+				timeout: 10 * time.Millisecond,
+			}
+
+			// The second message is not sent once the first fails
+			first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+			first.EXPECT().Nak().Return(nil)
+			second.EXPECT().Nak().Return(nil)
+
+			err := candidate.HandleJetstreamMessages(context.TODO(), testingHandlerBinding(), []repositories.JetstreamMessage{first, second})
+			assert.ErrorContains(t, err, tt.expect)
+		})
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_batchResponse(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nc := &fakeRequester{reply: func(_ context.Context, msg *nats.Msg) (*nats.Msg, error) {
+		var batch jetbridge.JetstreamBatchedLambdaPayload
+		require.NoError(t, json.Unmarshal(msg.Data, &batch))
+		require.Len(t, batch, 3)
+
+		return &nats.Msg{
+			Subject: msg.Reply,
+			Data:    []byte(`{"batchItemFailures":[{"sequence":2},{"sequence":3,"terminate":true}]}`),
+		}, nil
+	}}
+
+	binding := testingHandlerBinding()
+	binding.MaxMessages = 3
+	binding.MaxLatency = time.Second
+
+	candidate := &MessageHandler{logger: zap.NewNop(), nc: nc, timeout: time.Second}
+
+	first, second, third := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2), mocks.NewTestingJetstreamMessage(ctrl, 3)
+	first.EXPECT().Ack().Return(nil)
+	second.EXPECT().Nak().Return(nil)
+	third.EXPECT().Term().Return(nil)
+
+	err := candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second, third})
+	assert.ErrorContains(t, err, "2 of 3 messages")
+	assert.Len(t, nc.requests, 1)
+}
@@ -4,42 +4,75 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/metrics"
 	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/tracing"
 	"github.com/go-test/deep"
 	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 var _ repositories.MessageSource = (*MessageSource)(nil)
 
-func NewMessageSource(js nats.JetStreamContext) (*MessageSource, error) {
-	zl, err := zap.NewDevelopment() // TODO: this needs to be managed better
-	if err != nil {
-		return nil, err
+// NewMessageSource returns a message source creating consumers tagged with the
+// deployment, as given to NewConsumers.
+func NewMessageSource(js nats.JetStreamContext, deployment string, logger *zap.Logger) (*MessageSource, error) {
+	if deployment == "" {
+		return nil, errors.New("deployment must be set")
 	}
 
 	return &MessageSource{
-		logger:        zl,
+		logger:        logger.With(zap.String("component", "jetstream")),
 		js:            js,
+		deployment:    deployment,
 		mu:            &sync.Mutex{},
-		subscriptions: make(map[string]*nats.Subscription),
+		subscriptions: make(map[string]*subscription),
 	}, nil
 }
 
 type MessageSource struct {
-	logger *zap.Logger
-	js     nats.JetStreamContext
+	logger     *zap.Logger
+	js         nats.JetStreamContext
+	deployment string
 
 	mu            *sync.Mutex
-	subscriptions map[string]*nats.Subscription
+	subscriptions map[string]*subscription
+}
+
+// subscription is a cached set of pull subscriptions, along with the consumer
+// config they were bound with. If a binding is updated, the desired config will
+// no longer match and the consumer is reconciled before re-subscribing.
+//
+// Each concurrent fetch against a binding takes its own pull subscription from
+// idle, so that in-flight fetches do not receive each others messages.
+type subscription struct {
+	config nats.ConsumerConfig
+	idle   chan *nats.Subscription
+	stale  bool
 }
 
-func (m *MessageSource) FetchJetstreamMessages(ctx context.Context, binding repositories.JetstreamBinding) ([]repositories.JetstreamMessage, error) {
+func (m *MessageSource) FetchJetstreamMessages(ctx context.Context, binding repositories.JetstreamBinding) (_ []repositories.JetstreamMessage, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "jetstream.fetch", trace.WithAttributes(
+		attribute.String("jetbridge.binding_id", binding.ID.String()),
+		attribute.String("messaging.destination.name", binding.Stream),
+		attribute.String("messaging.nats.consumer", binding.Consumer.String()),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	var (
 		batchSize    = 1
 		batchLatency = 30 * time.Second
@@ -50,63 +83,143 @@ func (m *MessageSource) FetchJetstreamMessages(ctx context.Context, binding repo
 		batchLatency = binding.MaxLatency
 	}
 
-	sub, err := m.subscription(ctx, binding)
+	cached, err := m.subscription(ctx, binding)
 	if err != nil {
 		return nil, err
 	}
 
+	var sub *nats.Subscription
+	select {
+	case s, ok := <-cached.idle:
+		if !ok {
+			return nil, errors.New("binding was updated while waiting to fetch messages")
+		}
+		sub = s
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer m.release(cached, sub)
+
 	fetchCtx, cancel := context.WithTimeout(ctx, batchLatency)
 	defer cancel()
 
 	msgs, err := sub.Fetch(batchSize, nats.Context(fetchCtx))
-	if err != nil {
+	switch {
+	case (errors.Is(err, context.DeadlineExceeded) || errors.Is(err, nats.ErrTimeout)) && ctx.Err() == nil:
+		// No messages arrived within the batch latency, this is not a failure
+		return nil, nil
+	case err != nil:
 		return nil, fmt.Errorf("failed to fetch messages: %w", err)
 	}
 
-	return newMessages(msgs)
+	messages, err := newMessages(m.js, m.logger, binding, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("messaging.batch.message_count", len(messages)))
+	metrics.MessagesFetched.WithLabelValues(binding.ID.String()).Add(float64(len(messages)))
+	if len(messages) > 0 {
+		metrics.ConsumerPending.WithLabelValues(binding.ID.String()).Set(float64(messages[len(messages)-1].Payload().Metadata.NumPending))
+	}
+
+	return messages, nil
 }
 
-func (m *MessageSource) subscription(ctx context.Context, binding repositories.JetstreamBinding) (*nats.Subscription, error) {
+// release returns a pull subscription to the idle set once a fetch is done
+// with it, or unsubscribes it if the binding has since been updated.
+func (m *MessageSource) release(cached *subscription, sub *nats.Subscription) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if sub, ok := m.subscriptions[binding.ID.String()]; ok {
-		return sub, nil
+	if !cached.stale {
+		cached.idle <- sub
+		return
+	}
+
+	if err := sub.Unsubscribe(); err != nil {
+		m.logger.Warn("failed to unsubscribe stale subscription", zap.Error(err))
+	}
+}
+
+func (m *MessageSource) CloseJetstreamBinding(binding repositories.JetstreamBinding) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.drop(binding)
+}
+
+// drop marks the binding's subscriptions as stale, unsubscribing those that are
+// idle. Those in use are unsubscribed once their fetch completes. This does not
+// delete the consumer as it was bound. The caller must hold m.mu.
+func (m *MessageSource) drop(binding repositories.JetstreamBinding) {
+	cached, ok := m.subscriptions[binding.ID.String()]
+	if !ok {
+		return
 	}
 
+	cached.stale = true
+	for len(cached.idle) > 0 {
+		if err := (<-cached.idle).Unsubscribe(); err != nil {
+			m.logger.Warn("failed to unsubscribe stale subscription", zap.String("binding_id", binding.ID.String()), zap.String("stream", binding.Stream), zap.Error(err))
+		}
+	}
+	close(cached.idle)
+	delete(m.subscriptions, binding.ID.String())
+}
+
+func (m *MessageSource) subscription(ctx context.Context, binding repositories.JetstreamBinding) (*subscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	desiredConfig := &nats.ConsumerConfig{
 		Durable:           binding.Consumer.String(),
 		Name:              binding.Consumer.String(),
-		Description:       fmt.Sprintf("JetBridge Lambda consumer for %s", binding.LambdaARN),
-		DeliverPolicy:     nats.DeliverAllPolicy, // TODO: does this need exposing in the binding?
-		AckPolicy:         nats.AckAllPolicy,
-		AckWait:           time.Minute, // TODO: does this need exposing in the binding? Can we infer it from lambda timeout?
-		MaxDeliver:        -1,          // TODO: does this need exposing in the binding?
+		Description:       consumerDescription(m.deployment, binding.ID),
+		AckPolicy:         nats.AckExplicitPolicy, // Batches may be partially successful, so each message is ACK'd individually
+		AckWait:           time.Minute,            // TODO: does this need exposing in the binding? Can we infer it from lambda timeout?
+		MaxDeliver:        -1,                     // Delivery limits are enforced by the message handler, so messages can be dead-lettered before being given up on
 		FilterSubject:     binding.Subject,
 		ReplayPolicy:      nats.ReplayInstantPolicy,
-		MaxWaiting:        1, // Only one worker should be processing a message at a time (in most cases), we may as well ask NATS to enforce this
-		MaxAckPending:     1,
+		MaxWaiting:        repositories.MaxConcurrencyLimit, // Each concurrent fetch is one waiting pull request, and this cannot be raised later
+		MaxAckPending:     binding.Concurrency(),
 		FlowControl:       false, // TODO: is this right? what are the implications of this?
 		MaxRequestBatch:   1,
 		MaxRequestExpires: time.Minute,
 	}
 
 	if binding.MaxMessages > 0 && binding.MaxLatency > 0 {
-		desiredConfig.MaxAckPending = binding.MaxMessages
+		desiredConfig.MaxAckPending = binding.MaxMessages * binding.Concurrency()
 		desiredConfig.MaxRequestBatch = binding.MaxMessages
 		desiredConfig.MaxRequestExpires = binding.MaxLatency
 	}
 
+	if cached, ok := m.subscriptions[binding.ID.String()]; ok {
+		if diff := deep.Equal(cached.config, *desiredConfig); diff == nil {
+			return cached, nil
+		}
+
+		// The binding has been updated since we subscribed
+		m.drop(binding)
+	}
+
 	infoCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	info, err := m.js.ConsumerInfo(binding.Stream, binding.Consumer.String(), nats.Context(infoCtx))
 	switch {
 	case errors.Is(err, nats.ErrConsumerNotFound):
+		// Where the consumer starts reading from only matters when it is
+		// created, once it exists it carries on from where it left off
+		createConfig := *desiredConfig
+		if err := applyDeliveryPolicy(&createConfig, binding.DeliveryPolicy); err != nil {
+			return nil, err
+		}
+
 		createCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 		defer cancel()
 
-		if _, err := m.js.AddConsumer(binding.Stream, desiredConfig, nats.Context(createCtx)); err != nil {
+		if _, err := m.js.AddConsumer(binding.Stream, &createConfig, nats.Context(createCtx)); err != nil {
 			return nil, fmt.Errorf("failed to create consumer: %w", err)
 		}
 
@@ -114,26 +227,177 @@ func (m *MessageSource) subscription(ctx context.Context, binding repositories.J
 		return nil, fmt.Errorf("failed to get consumer info: %w", err)
 
 	default:
-		if diff := deep.Equal(info.Config, *desiredConfig); diff != nil {
+		if upgradedConfig, ok := upgradeConsumerConfig(info, desiredConfig); ok {
+			m.logger.Warn(
+				"recreating consumer created by an older version",
+				zap.String("binding_id", binding.ID.String()),
+				zap.String("stream", binding.Stream),
+				zap.Uint64("start_sequence", upgradedConfig.OptStartSeq),
+			)
+
+			if err := m.recreateConsumer(ctx, binding, upgradedConfig); err != nil {
+				return nil, err
+			}
+
+			break
+		}
+
+		reconciledConfig, err := reconcileConsumerConfig(info.Config, desiredConfig)
+		if err != nil {
 			return nil, fmt.Errorf(
-				"consumer config (%s:%s) does not match desired config: %s",
-				binding.Stream, binding.Consumer.String(), diff,
+				"consumer config (%s:%s) does not match desired config: %w",
+				binding.Stream, binding.Consumer.String(), err,
+			)
+		}
+
+		if diff := deep.Equal(info.Config, *reconciledConfig); diff != nil {
+			m.logger.Info(
+				"updating consumer config",
+				zap.String("binding_id", binding.ID.String()),
+				zap.String("stream", binding.Stream),
+				zap.Strings("diff", diff),
 			)
+
+			updateCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+
+			if _, err := m.js.UpdateConsumer(binding.Stream, reconciledConfig, nats.Context(updateCtx)); err != nil {
+				return nil, fmt.Errorf("failed to update consumer: %w", err)
+			}
 		}
 	}
 
-	sub, err := m.js.PullSubscribe(binding.Subject, binding.Consumer.String(), nats.Bind(binding.Stream, binding.Consumer.String()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to subscribe to jetstream binding: %w", err)
+	cached := &subscription{
+		config: *desiredConfig,
+		idle:   make(chan *nats.Subscription, binding.Concurrency()),
 	}
 
-	m.subscriptions[binding.ID.String()] = sub
-	return sub, nil
+	for i := 0; i < binding.Concurrency(); i++ {
+		sub, err := m.js.PullSubscribe(binding.Subject, binding.Consumer.String(), nats.Bind(binding.Stream, binding.Consumer.String()))
+		if err != nil {
+			for len(cached.idle) > 0 {
+				_ = (<-cached.idle).Unsubscribe()
+			}
+
+			return nil, fmt.Errorf("failed to subscribe to jetstream binding: %w", err)
+		}
+
+		cached.idle <- sub
+	}
+
+	m.subscriptions[binding.ID.String()] = cached
+	return cached, nil
+}
+
+// recreateConsumer deletes the binding's consumer and creates it again with
+// the config. Should this fail part way through, the consumer is created with
+// the binding's delivery policy on the next fetch.
+func (m *MessageSource) recreateConsumer(ctx context.Context, binding repositories.JetstreamBinding, config *nats.ConsumerConfig) error {
+	deleteCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := m.js.DeleteConsumer(binding.Stream, binding.Consumer.String(), nats.Context(deleteCtx)); err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+		return fmt.Errorf("failed to delete consumer: %w", err)
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := m.js.AddConsumer(binding.Stream, config, nats.Context(createCtx)); err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	return nil
+}
+
+// upgradeConsumerConfig returns the config to recreate an existing consumer
+// with, if it was created by an older version with immutable fields that no
+// longer match. Such consumers ACK'd every message up to the last one ACK'd,
+// and allowed only one waiting pull request, so could neither settle messages
+// individually nor serve concurrent fetches.
+//
+// The recreated consumer starts after the old consumer's ACK floor, so no
+// message it had not yet ACK'd is lost.
+func upgradeConsumerConfig(current *nats.ConsumerInfo, desired *nats.ConsumerConfig) (*nats.ConsumerConfig, bool) {
+	if current.Config.AckPolicy == desired.AckPolicy && current.Config.MaxWaiting >= desired.MaxWaiting {
+		return nil, false
+	}
+
+	upgraded := *desired
+	upgraded.DeliverPolicy = nats.DeliverByStartSequencePolicy
+	upgraded.OptStartSeq = current.AckFloor.Stream + 1
+	upgraded.OptStartTime = nil
+
+	return &upgraded, true
+}
+
+// applyDeliveryPolicy sets the fields of the consumer config that control
+// where in the stream the consumer starts reading from.
+func applyDeliveryPolicy(config *nats.ConsumerConfig, policy repositories.DeliveryPolicy) error {
+	switch policy.Deliver {
+	case repositories.DeliverAll:
+		config.DeliverPolicy = nats.DeliverAllPolicy
+	case repositories.DeliverLast:
+		config.DeliverPolicy = nats.DeliverLastPolicy
+	case repositories.DeliverLastPerSubject:
+		config.DeliverPolicy = nats.DeliverLastPerSubjectPolicy
+	case repositories.DeliverNew:
+		config.DeliverPolicy = nats.DeliverNewPolicy
+	case repositories.DeliverByStartTime:
+		startTime := policy.StartTime
+		config.DeliverPolicy = nats.DeliverByStartTimePolicy
+		config.OptStartTime = &startTime
+	case repositories.DeliverByStartSequence:
+		config.DeliverPolicy = nats.DeliverByStartSequencePolicy
+		config.OptStartSeq = policy.StartSequence
+	default:
+		return fmt.Errorf("unsupported delivery policy: %s", policy)
+	}
+
+	return nil
+}
+
+// reconcileConsumerConfig applies the mutable fields of the desired config
+// onto the current config of an existing consumer. Fields that JetStream does
+// not allow to be changed on an existing consumer must already match,
+// otherwise an error describing the difference is returned.
+//
+// The delivery policy is only applied when a consumer is created, so is left
+// as the consumer was created. Consumers created before bindings had delivery
+// policies always deliver all messages, whatever their binding's policy.
+func reconcileConsumerConfig(current nats.ConsumerConfig, desired *nats.ConsumerConfig) (*nats.ConsumerConfig, error) {
+	immutable := func(c nats.ConsumerConfig) nats.ConsumerConfig {
+		return nats.ConsumerConfig{
+			Durable:      c.Durable,
+			AckPolicy:    c.AckPolicy,
+			ReplayPolicy: c.ReplayPolicy,
+			FlowControl:  c.FlowControl,
+		}
+	}
+
+	if diff := deep.Equal(immutable(current), immutable(*desired)); diff != nil {
+		return nil, fmt.Errorf("immutable fields differ: %s", diff)
+	}
+
+	// MaxWaiting cannot be changed either, so is left as the consumer was
+	// created, allowing as many waiting pull requests as any binding needs.
+	reconciled := current
+	reconciled.Description = desired.Description
+	reconciled.AckWait = desired.AckWait
+	reconciled.MaxDeliver = desired.MaxDeliver
+	reconciled.FilterSubject = desired.FilterSubject
+	reconciled.MaxAckPending = desired.MaxAckPending
+	reconciled.MaxRequestBatch = desired.MaxRequestBatch
+	reconciled.MaxRequestExpires = desired.MaxRequestExpires
+
+	return &reconciled, nil
 }
 
 type Message struct {
-	md  *nats.MsgMetadata
-	msg *nats.Msg
+	js        nats.JetStreamContext
+	bindingID string
+	md        *nats.MsgMetadata
+	msg       *nats.Msg
 }
 
 func (m *Message) Payload() jetbridge.JetstreamLambdaPayload {
@@ -146,18 +410,83 @@ func (m *Message) Payload() jetbridge.JetstreamLambdaPayload {
 }
 
 func (m *Message) Ack() error {
-	return m.msg.Ack() // TODO: add necessary options
+	if err := m.msg.Ack(); err != nil { // TODO: add necessary options
+		return err
+	}
+
+	metrics.MessagesAcked.WithLabelValues(m.bindingID).Inc()
+	return nil
 }
 
 func (m *Message) Nak() error {
-	return m.msg.Nak() // TODO: add necessary options
+	if err := m.msg.Nak(); err != nil { // TODO: add necessary options
+		return err
+	}
+
+	metrics.MessagesNakked.WithLabelValues(m.bindingID).Inc()
+	return nil
+}
+
+func (m *Message) NakWithDelay(delay time.Duration) error {
+	if err := m.msg.NakWithDelay(delay); err != nil {
+		return err
+	}
+
+	metrics.MessagesNakked.WithLabelValues(m.bindingID).Inc()
+	return nil
+}
+
+func (m *Message) Term() error {
+	if err := m.msg.Term(); err != nil {
+		return err
+	}
+
+	metrics.MessagesTerminated.WithLabelValues(m.bindingID).Inc()
+	return nil
 }
 
-func newMessages(msgs []*nats.Msg) ([]repositories.JetstreamMessage, error) {
+func (m *Message) DeadLetter(subject string, header nats.Header) error {
+	dlq := nats.NewMsg(subject)
+	dlq.Data = m.msg.Data
+	dlq.Header = deadLetterHeader(m.msg.Header, header)
+
+	if _, err := m.js.PublishMsg(dlq); err != nil {
+		return fmt.Errorf("failed to publish to dead letter subject %s: %w", subject, err)
+	}
+
+	metrics.MessagesDeadLettered.WithLabelValues(m.bindingID).Inc()
+	return nil
+}
+
+// deadLetterHeader returns the headers of a dead-lettered message, which are
+// those of the original message with the dead-letter headers added. NATS
+// control headers, such as Nats-Msg-Id and Nats-Expected-Stream, applied to
+// the original publish and would otherwise have the dead-letter publish
+// deduplicated or rejected, so are left out.
+func deadLetterHeader(original, deadLetter nats.Header) nats.Header {
+	header := nats.Header{}
+	for k, v := range original {
+		if strings.HasPrefix(strings.ToLower(k), "nats-") {
+			continue
+		}
+
+		header[k] = append([]string(nil), v...)
+	}
+
+	for k, v := range deadLetter {
+		header[k] = append([]string(nil), v...)
+	}
+
+	return header
+}
+
+func newMessages(js nats.JetStreamContext, logger *zap.Logger, binding repositories.JetstreamBinding, msgs []*nats.Msg) ([]repositories.JetstreamMessage, error) {
+	bindingID := binding.ID.String()
+
 	cleanup := func() {
 		for _, msg := range msgs {
 			if err := msg.Nak(); err != nil {
-				log.Printf("failed to nack message: %v", err)
+				logger.Error("failed to NAK message", zap.String("binding_id", bindingID), zap.String("stream", binding.Stream), zap.Error(err))
 			}
 		}
 	}
@@ -171,8 +500,10 @@ func newMessages(msgs []*nats.Msg) ([]repositories.JetstreamMessage, error) {
 		}
 
 		messages = append(messages, &Message{
-			md:  md,
-			msg: msg,
+			js:        js,
+			bindingID: bindingID,
+			md:        md,
+			msg:       msg,
 		})
 	}
 
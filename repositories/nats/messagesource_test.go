@@ -6,12 +6,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/JoeReid/jetbridge"
 	"github.com/JoeReid/jetbridge/repositories"
 	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
 	"github.com/ory/dockertest/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestMessageSource_FetchJetstreamMessages(t *testing.T) {
@@ -36,9 +38,11 @@ func TestMessageSource_FetchJetstreamMessages(t *testing.T) {
 	require.NoError(t, err)
 
 	candidate := &MessageSource{
+		logger:        zap.NewNop(),
 		js:            js,
+		deployment:    "test",
 		mu:            &sync.Mutex{},
-		subscriptions: make(map[string]*nats.Subscription),
+		subscriptions: make(map[string]*subscription),
 	}
 
 	id := uuid.New()
@@ -46,7 +50,7 @@ func TestMessageSource_FetchJetstreamMessages(t *testing.T) {
 	t.Run("consumer not exist", func(t *testing.T) {
 		msgs, err := candidate.FetchJetstreamMessages(context.TODO(), repositories.JetstreamBinding{
 			ID:          id,
-			LambdaARN:   "test-arn",
+			Target:      repositories.Target{URI: "test-arn"},
 			Stream:      "TESTSTREAM",
 			Consumer:    id,
 			Subject:     "TESTSTREAM.*",
@@ -64,7 +68,7 @@ func TestMessageSource_FetchJetstreamMessages(t *testing.T) {
 	t.Run("consumer exists", func(t *testing.T) {
 		msgs, err := candidate.FetchJetstreamMessages(context.TODO(), repositories.JetstreamBinding{
 			ID:          id,
-			LambdaARN:   "test-arn",
+			Target:      repositories.Target{URI: "test-arn"},
 			Stream:      "TESTSTREAM",
 			Consumer:    id,
 			Subject:     "TESTSTREAM.*",
@@ -78,6 +82,316 @@ func TestMessageSource_FetchJetstreamMessages(t *testing.T) {
 			assert.NoError(t, msg.Ack())
 		}
 	})
+
+	t.Run("binding updated", func(t *testing.T) {
+		_, err = js.Publish("TESTSTREAM.5", []byte("test message 5"))
+		require.NoError(t, err)
+
+		_, err = js.Publish("TESTSTREAM.6", []byte("test message 6"))
+		require.NoError(t, err)
+
+		msgs, err := candidate.FetchJetstreamMessages(context.TODO(), repositories.JetstreamBinding{
+			ID:          id,
+			Target:      repositories.Target{URI: "updated-test-arn"},
+			Stream:      "TESTSTREAM",
+			Consumer:    id,
+			Subject:     "TESTSTREAM.*",
+			MaxMessages: 3,
+			MaxLatency:  time.Second,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, msgs, 2)
+
+		for _, msg := range msgs {
+			assert.NoError(t, msg.Ack())
+		}
+
+		info, err := js.ConsumerInfo("TESTSTREAM", id.String())
+		require.NoError(t, err)
+		assert.Equal(t, 3, info.Config.MaxAckPending)
+		assert.Equal(t, "JetBridge consumer of test for binding "+id.String(), info.Config.Description)
+	})
+
+	t.Run("concurrent fetches", func(t *testing.T) {
+		concurrentID := uuid.New()
+		binding := repositories.JetstreamBinding{
+			ID:             concurrentID,
+			Target:         repositories.Target{URI: "test-arn"},
+			Stream:         "TESTSTREAM",
+			Consumer:       concurrentID,
+			Subject:        "TESTSTREAM.*",
+			MaxMessages:    2,
+			MaxLatency:     time.Second,
+			MaxConcurrency: 3,
+		}
+
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			seen = make(map[uint64]bool)
+		)
+
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				msgs, err := candidate.FetchJetstreamMessages(context.TODO(), binding)
+				assert.NoError(t, err)
+				assert.Len(t, msgs, 2)
+
+				mu.Lock()
+				defer mu.Unlock()
+				for _, msg := range msgs {
+					seq := msg.Payload().Metadata.Sequence.Stream
+					assert.False(t, seen[seq], "message %d fetched more than once", seq)
+					seen[seq] = true
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Len(t, seen, 6)
+
+		info, err := js.ConsumerInfo("TESTSTREAM", concurrentID.String())
+		require.NoError(t, err)
+		assert.Equal(t, 6, info.Config.MaxAckPending)
+		assert.Equal(t, repositories.MaxConcurrencyLimit, info.Config.MaxWaiting)
+		assert.Equal(t, 6, info.NumAckPending)
+	})
+
+	t.Run("baseline consumer", func(t *testing.T) {
+		_, err := js.AddStream(&nats.StreamConfig{
+			Name:     "UPGRADESTREAM",
+			Subjects: []string{"UPGRADESTREAM.*"},
+		}, nats.MaxWait(5*time.Second))
+		require.NoError(t, err)
+
+		for _, subject := range []string{"UPGRADESTREAM.1", "UPGRADESTREAM.2", "UPGRADESTREAM.3", "UPGRADESTREAM.4"} {
+			_, err = js.Publish(subject, []byte("test message"))
+			require.NoError(t, err)
+		}
+
+		baselineID := uuid.New()
+		_, err = js.AddConsumer("UPGRADESTREAM", &nats.ConsumerConfig{
+			Durable:           baselineID.String(),
+			Name:              baselineID.String(),
+			Description:       "JetBridge Lambda consumer for test-arn",
+			DeliverPolicy:     nats.DeliverAllPolicy,
+			AckPolicy:         nats.AckAllPolicy,
+			AckWait:           time.Minute,
+			MaxDeliver:        -1,
+			FilterSubject:     "UPGRADESTREAM.*",
+			ReplayPolicy:      nats.ReplayInstantPolicy,
+			MaxWaiting:        1,
+			MaxAckPending:     1,
+			MaxRequestBatch:   1,
+			MaxRequestExpires: time.Minute,
+		})
+		require.NoError(t, err)
+
+		// Process the first message as the baseline did, ACKing all up to it
+		sub, err := js.PullSubscribe("UPGRADESTREAM.*", baselineID.String(), nats.Bind("UPGRADESTREAM", baselineID.String()))
+		require.NoError(t, err)
+
+		msgs, err := sub.Fetch(1, nats.MaxWait(time.Second))
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.NoError(t, msgs[0].AckSync())
+		require.NoError(t, sub.Unsubscribe())
+
+		binding := repositories.JetstreamBinding{
+			ID:             baselineID,
+			Target:         repositories.Target{URI: "test-arn"},
+			Stream:         "UPGRADESTREAM",
+			Consumer:       baselineID,
+			Subject:        "UPGRADESTREAM.*",
+			MaxMessages:    3,
+			MaxLatency:     time.Second,
+			MaxConcurrency: 2,
+		}
+
+		fetched, err := candidate.FetchJetstreamMessages(context.TODO(), binding)
+		require.NoError(t, err)
+		require.Len(t, fetched, 3)
+		for i, msg := range fetched {
+			assert.Equal(t, uint64(i+2), msg.Payload().Metadata.Sequence.Stream, "messages after the ACK floor should be delivered")
+			assert.NoError(t, msg.Ack())
+		}
+
+		info, err := js.ConsumerInfo("UPGRADESTREAM", baselineID.String())
+		require.NoError(t, err)
+		assert.Equal(t, nats.AckExplicitPolicy, info.Config.AckPolicy)
+		assert.Equal(t, repositories.MaxConcurrencyLimit, info.Config.MaxWaiting)
+	})
+
+	t.Run("concurrency raised", func(t *testing.T) {
+		raisedID := uuid.New()
+		binding := repositories.JetstreamBinding{
+			ID:          raisedID,
+			Target:      repositories.Target{URI: "test-arn"},
+			Stream:      "TESTSTREAM",
+			Consumer:    raisedID,
+			Subject:     "TESTSTREAM.*",
+			MaxMessages: 2,
+			MaxLatency:  time.Second,
+		}
+
+		msgs, err := candidate.FetchJetstreamMessages(context.TODO(), binding)
+		require.NoError(t, err)
+		for _, msg := range msgs {
+			assert.NoError(t, msg.Ack())
+		}
+
+		binding.MaxConcurrency = 4
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				msgs, err := candidate.FetchJetstreamMessages(context.TODO(), binding)
+				assert.NoError(t, err)
+				for _, msg := range msgs {
+					assert.NoError(t, msg.Ack())
+				}
+			}()
+		}
+		wg.Wait()
+
+		info, err := js.ConsumerInfo("TESTSTREAM", raisedID.String())
+		require.NoError(t, err)
+		assert.Equal(t, 8, info.Config.MaxAckPending)
+	})
+}
+
+func TestReconcileConsumerConfig(t *testing.T) {
+	current := nats.ConsumerConfig{
+		Durable:         "consumer",
+		DeliverPolicy:   nats.DeliverAllPolicy,
+		AckPolicy:       nats.AckAllPolicy,
+		FilterSubject:   "TESTSTREAM.*",
+		MaxAckPending:   1,
+		MaxRequestBatch: 1,
+		Replicas:        1,
+	}
+
+	t.Run("mutable fields", func(t *testing.T) {
+		desired := current
+		desired.Replicas = 0
+		desired.FilterSubject = "TESTSTREAM.1"
+		desired.MaxAckPending = 10
+		desired.MaxRequestBatch = 10
+
+		reconciled, err := reconcileConsumerConfig(current, &desired)
+		require.NoError(t, err)
+		assert.Equal(t, "TESTSTREAM.1", reconciled.FilterSubject)
+		assert.Equal(t, 10, reconciled.MaxAckPending)
+		assert.Equal(t, 10, reconciled.MaxRequestBatch)
+		assert.Equal(t, 1, reconciled.Replicas, "server side defaults should be preserved")
+	})
+
+	t.Run("immutable fields", func(t *testing.T) {
+		desired := current
+		desired.ReplayPolicy = nats.ReplayOriginalPolicy
+
+		_, err := reconcileConsumerConfig(current, &desired)
+		assert.Error(t, err)
+	})
+
+	t.Run("delivery policy", func(t *testing.T) {
+		startTime := time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)
+
+		for _, policy := range []repositories.DeliveryPolicy{
+			{Deliver: repositories.DeliverNew},
+			{Deliver: repositories.DeliverByStartTime, StartTime: startTime},
+			{Deliver: repositories.DeliverByStartSequence, StartSequence: 42},
+		} {
+			desired := current
+			require.NoError(t, applyDeliveryPolicy(&desired, policy))
+
+			reconciled, err := reconcileConsumerConfig(current, &desired)
+			require.NoError(t, err, "consumers created before delivery policies deliver all messages")
+			assert.Equal(t, nats.DeliverAllPolicy, reconciled.DeliverPolicy)
+			assert.Zero(t, reconciled.OptStartSeq)
+			assert.Nil(t, reconciled.OptStartTime)
+		}
+	})
+
+	t.Run("max waiting", func(t *testing.T) {
+		current := current
+		current.MaxWaiting = 4
+
+		for _, maxWaiting := range []int{2, 8} {
+			desired := current
+			desired.MaxWaiting = maxWaiting
+
+			reconciled, err := reconcileConsumerConfig(current, &desired)
+			require.NoError(t, err)
+			assert.Equal(t, 4, reconciled.MaxWaiting, "max waiting cannot be changed, so should be left as is")
+		}
+	})
+}
+
+func TestUpgradeConsumerConfig(t *testing.T) {
+	// The config consumers were created with before messages were settled
+	// individually or fetched concurrently
+	baseline := nats.ConsumerConfig{
+		Durable:           "consumer",
+		Name:              "consumer",
+		Description:       "JetBridge Lambda consumer for test-arn",
+		DeliverPolicy:     nats.DeliverAllPolicy,
+		AckPolicy:         nats.AckAllPolicy,
+		AckWait:           time.Minute,
+		MaxDeliver:        -1,
+		FilterSubject:     "TESTSTREAM.*",
+		ReplayPolicy:      nats.ReplayInstantPolicy,
+		MaxWaiting:        1,
+		MaxAckPending:     1,
+		MaxRequestBatch:   1,
+		MaxRequestExpires: time.Minute,
+	}
+
+	desired := baseline
+	desired.Description = consumerDescription("test", uuid.New())
+	desired.DeliverPolicy = 0
+	desired.AckPolicy = nats.AckExplicitPolicy
+	desired.MaxWaiting = repositories.MaxConcurrencyLimit
+
+	t.Run("baseline consumer", func(t *testing.T) {
+		current := &nats.ConsumerInfo{
+			Config:   baseline,
+			AckFloor: nats.SequenceInfo{Consumer: 41, Stream: 41},
+		}
+
+		upgraded, ok := upgradeConsumerConfig(current, &desired)
+		require.True(t, ok)
+
+		expected := desired
+		expected.DeliverPolicy = nats.DeliverByStartSequencePolicy
+		expected.OptStartSeq = 42
+		assert.Equal(t, &expected, upgraded)
+	})
+
+	t.Run("baseline max waiting", func(t *testing.T) {
+		current := &nats.ConsumerInfo{Config: desired}
+		current.Config.MaxWaiting = 1
+
+		upgraded, ok := upgradeConsumerConfig(current, &desired)
+		require.True(t, ok)
+		assert.Equal(t, repositories.MaxConcurrencyLimit, upgraded.MaxWaiting)
+		assert.Equal(t, uint64(1), upgraded.OptStartSeq)
+	})
+
+	t.Run("current consumer", func(t *testing.T) {
+		current := &nats.ConsumerInfo{
+			Config:   desired,
+			AckFloor: nats.SequenceInfo{Consumer: 41, Stream: 41},
+		}
+
+		_, ok := upgradeConsumerConfig(current, &desired)
+		assert.False(t, ok)
+	})
 }
 
 func testingNATS(t *testing.T) nats.JetStreamContext {
@@ -126,3 +440,71 @@ func testingNATS(t *testing.T) nats.JetStreamContext {
 
 	return js
 }
+
+func TestApplyDeliveryPolicy(t *testing.T) {
+	startTime := time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		policy   repositories.DeliveryPolicy
+		expected nats.ConsumerConfig
+	}{
+		{
+			name:     "all",
+			policy:   repositories.DeliveryPolicy{Deliver: repositories.DeliverAll},
+			expected: nats.ConsumerConfig{DeliverPolicy: nats.DeliverAllPolicy},
+		},
+		{
+			name:     "last",
+			policy:   repositories.DeliveryPolicy{Deliver: repositories.DeliverLast},
+			expected: nats.ConsumerConfig{DeliverPolicy: nats.DeliverLastPolicy},
+		},
+		{
+			name:     "last per subject",
+			policy:   repositories.DeliveryPolicy{Deliver: repositories.DeliverLastPerSubject},
+			expected: nats.ConsumerConfig{DeliverPolicy: nats.DeliverLastPerSubjectPolicy},
+		},
+		{
+			name:     "new",
+			policy:   repositories.DeliveryPolicy{Deliver: repositories.DeliverNew},
+			expected: nats.ConsumerConfig{DeliverPolicy: nats.DeliverNewPolicy},
+		},
+		{
+			name:     "start time",
+			policy:   repositories.DeliveryPolicy{Deliver: repositories.DeliverByStartTime, StartTime: startTime},
+			expected: nats.ConsumerConfig{DeliverPolicy: nats.DeliverByStartTimePolicy, OptStartTime: &startTime},
+		},
+		{
+			name:     "start sequence",
+			policy:   repositories.DeliveryPolicy{Deliver: repositories.DeliverByStartSequence, StartSequence: 42},
+			expected: nats.ConsumerConfig{DeliverPolicy: nats.DeliverByStartSequencePolicy, OptStartSeq: 42},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config nats.ConsumerConfig
+			require.NoError(t, applyDeliveryPolicy(&config, tt.policy))
+			assert.Equal(t, tt.expected, config)
+		})
+	}
+}
+
+func TestDeadLetterHeader(t *testing.T) {
+	original := nats.Header{
+		"Customer-Id":                   []string{"42"},
+		nats.MsgIdHdr:                   []string{"order-1"},
+		nats.ExpectedStreamHdr:          []string{"TESTSTREAM"},
+		nats.ExpectedLastSubjSeqHdr:     []string{"7"},
+		"nats-expected-last-sequence":   []string{"6"},
+		jetbridge.DeadLetterErrorHeader: []string{"stale"},
+	}
+
+	deadLetter := nats.Header{}
+	deadLetter.Set(jetbridge.DeadLetterErrorHeader, "failed")
+
+	assert.Equal(t, nats.Header{
+		"Customer-Id":                   []string{"42"},
+		jetbridge.DeadLetterErrorHeader: []string{"failed"},
+	}, deadLetterHeader(original, deadLetter))
+}
@@ -0,0 +1,140 @@
+package natskv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+var _ repositories.Bindings = (*Bindings)(nil)
+
+type Bindings struct {
+	kv    nats.KeyValue
+	peers nats.KeyValue
+}
+
+func (b *Bindings) CreateJetstreamBinding(ctx context.Context, create *repositories.CreateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	record := newJetstreamBinding(create)
+
+	if _, err := putRecord(b.kv, record.ID.String(), record, 0); err != nil {
+		return nil, fmt.Errorf("failed to create jetstream binding: %w", err)
+	}
+
+	peers, err := listPeers(b.peers)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.toJetstreamBinding(peers), nil
+}
+
+func (b *Bindings) GetJetstreamBinding(ctx context.Context, id uuid.UUID) (*repositories.JetstreamBinding, error) {
+	peers, err := listPeers(b.peers)
+	if err != nil {
+		return nil, err
+	}
+
+	record, _, err := getRecord[jetstreamBindingRecord](b.kv, id.String())
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			return nil, fmt.Errorf("jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+		}
+
+		return nil, err
+	}
+
+	return record.toJetstreamBinding(peers), nil
+}
+
+func (b *Bindings) ListJetstreamBindings(ctx context.Context) ([]repositories.JetstreamBinding, error) {
+	peers, err := listPeers(b.peers)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := keys(b.kv)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*jetstreamBindingRecord
+	for _, key := range keys {
+		record, _, err := getRecord[jetstreamBindingRecord](b.kv, key)
+		if err != nil {
+			// The binding was deleted after the keys were listed
+			if errors.Is(err, nats.ErrKeyNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].CreatedAt.Before(records[j].CreatedAt) })
+
+	bindings := make([]repositories.JetstreamBinding, len(records))
+	for i, record := range records {
+		bindings[i] = *record.toJetstreamBinding(peers)
+	}
+
+	return bindings, nil
+}
+
+func (b *Bindings) UpdateJetstreamBinding(ctx context.Context, id uuid.UUID, update *repositories.UpdateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	record, err := updateRecord(b.kv, id.String(), func(record *jetstreamBindingRecord) error {
+		// Checked against the revision being replaced, so a concurrent update
+		// cannot leave the binding invalid
+		if err := record.toJetstreamBinding(nil).ValidateUpdate(update); err != nil {
+			return err
+		}
+
+		record.applyUpdate(update)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+		}
+
+		if errors.Is(err, repositories.ErrInvalidBinding) {
+			return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, err)
+		}
+
+		return nil, fmt.Errorf("failed to update jetstream binding: %w", err)
+	}
+
+	peers, err := listPeers(b.peers)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.toJetstreamBinding(peers), nil
+}
+
+func (b *Bindings) DeleteJetstreamBinding(ctx context.Context, id uuid.UUID) error {
+	return b.kv.Delete(id.String())
+}
+
+func NewBindings(js nats.JetStreamContext, bucketPrefix string) (*Bindings, error) {
+	kv, err := openBucket(js, bucketPrefix, bindingsBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := openBucket(js, bucketPrefix, peersBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bindings{
+		kv:    kv,
+		peers: peers,
+	}, nil
+}
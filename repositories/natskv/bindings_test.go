@@ -0,0 +1,35 @@
+package natskv
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNewBindings(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	bindings, err := NewBindings(js, "test")
+	require.NoError(t, err)
+	require.NotNil(t, bindings)
+}
+
+func TestBindingsConformance(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	peers, err := NewPeers(js, "test")
+	require.NoError(t, err)
+
+	bindings, err := NewBindings(js, "test")
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewBindingsConformanceSuite(peers, bindings))
+}
@@ -0,0 +1,32 @@
+package natskv
+
+import (
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+type bindingStatsRecord struct {
+	BindingID     uuid.UUID `json:"binding_id"`
+	PeerID        uuid.UUID `json:"peer_id"`
+	LeaseToken    int64     `json:"lease_token"`
+	RunningUntil  time.Time `json:"running_until"`
+	LastSuccessAt time.Time `json:"last_success_at"`
+	LastErrorAt   time.Time `json:"last_error_at"`
+	LastError     string    `json:"last_error"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (r *bindingStatsRecord) toBindingStats() *repositories.BindingStats {
+	return &repositories.BindingStats{
+		BindingID:     r.BindingID,
+		PeerID:        r.PeerID,
+		LeaseToken:    r.LeaseToken,
+		RecordedAt:    r.UpdatedAt,
+		RunningUntil:  r.RunningUntil,
+		LastSuccessAt: r.LastSuccessAt,
+		LastErrorAt:   r.LastErrorAt,
+		LastError:     r.LastError,
+	}
+}
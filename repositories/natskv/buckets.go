@@ -0,0 +1,153 @@
+package natskv
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	bindingsBucket = "bindings"
+	peersBucket    = "peers"
+	leasesBucket   = "leases"
+	statsBucket    = "stats"
+)
+
+const (
+	peerTTL  = 5 * time.Second
+	statsTTL = 7 * 24 * time.Hour // Outlives any running worker, cleaning up after deleted bindings
+
+	// Lease records outlive their expiry, cleaning up after deleted bindings.
+	// Stats writes are fenced by the lease token, which starts again from one
+	// if the lease record is deleted, so lease records must outlive stats.
+	leaseRecordTTL = 2 * statsTTL
+)
+
+// maxUpdateAttempts bounds how many times a read-modify-write is retried when
+// it races with another writer of the same key.
+const maxUpdateAttempts = 10
+
+// CreateBuckets creates the KV buckets used to store internal state, each
+// named with the given prefix. Buckets that already exist are left unchanged.
+func CreateBuckets(js nats.JetStreamContext, bucketPrefix string) error {
+	configs := []*nats.KeyValueConfig{
+		{
+			Bucket:      bucketName(bucketPrefix, bindingsBucket),
+			Description: "JetBridge bindings",
+		},
+		{
+			// Peers that stop sending heartbeats are expired by the bucket
+			Bucket:      bucketName(bucketPrefix, peersBucket),
+			Description: "JetBridge peers",
+			TTL:         peerTTL,
+		},
+		{
+			Bucket:      bucketName(bucketPrefix, leasesBucket),
+			Description: "JetBridge binding leases",
+			TTL:         leaseRecordTTL,
+		},
+		{
+			Bucket:      bucketName(bucketPrefix, statsBucket),
+			Description: "JetBridge binding stats",
+			TTL:         statsTTL,
+		},
+	}
+
+	for _, config := range configs {
+		if _, err := js.KeyValue(config.Bucket); err == nil {
+			continue
+		} else if !errors.Is(err, nats.ErrBucketNotFound) {
+			return fmt.Errorf("failed to get bucket %s: %w", config.Bucket, err)
+		}
+
+		if _, err := js.CreateKeyValue(config); err != nil {
+			return fmt.Errorf("failed to create bucket %s: %w", config.Bucket, err)
+		}
+	}
+
+	return nil
+}
+
+func bucketName(bucketPrefix, bucket string) string {
+	return bucketPrefix + "_" + bucket
+}
+
+func openBucket(js nats.JetStreamContext, bucketPrefix, bucket string) (nats.KeyValue, error) {
+	kv, err := js.KeyValue(bucketName(bucketPrefix, bucket))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bucket %s: %w", bucketName(bucketPrefix, bucket), err)
+	}
+
+	return kv, nil
+}
+
+// getRecord returns the record stored at key, and its revision.
+func getRecord[T any](kv nats.KeyValue, key string) (*T, uint64, error) {
+	entry, err := kv.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var record T
+	if err := json.Unmarshal(entry.Value(), &record); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+
+	return &record, entry.Revision(), nil
+}
+
+// putRecord stores the record at key, provided the key is still at revision.
+// A revision of zero creates the key, provided it does not already exist. If
+// the key has since been written the error matches nats.ErrKeyExists.
+func putRecord[T any](kv nats.KeyValue, key string, record *T, revision uint64) (uint64, error) {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+
+	if revision == 0 {
+		return kv.Create(key, value)
+	}
+
+	return kv.Update(key, value, revision)
+}
+
+// updateRecord applies fn to the record stored at key and writes it back,
+// retrying with the latest record if another writer updates the key first.
+func updateRecord[T any](kv nats.KeyValue, key string, fn func(*T) error) (*T, error) {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		record, revision, err := getRecord[T](kv, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := fn(record); err != nil {
+			return nil, err
+		}
+
+		if _, err := putRecord(kv, key, record, revision); err != nil {
+			if errors.Is(err, nats.ErrKeyExists) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		return record, nil
+	}
+
+	return nil, fmt.Errorf("failed to update %s: too many concurrent writes", key)
+}
+
+// keys lists the keys in a bucket, which may be empty.
+func keys(kv nats.KeyValue) ([]string, error) {
+	keys, err := kv.Keys()
+	if errors.Is(err, nats.ErrNoKeysFound) {
+		return nil, nil
+	}
+
+	return keys, err
+}
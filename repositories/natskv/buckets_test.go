@@ -0,0 +1,24 @@
+package natskv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBuckets(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	for _, bucket := range []string{"test_bindings", "test_peers", "test_leases", "test_stats"} {
+		_, err := js.KeyValue(bucket)
+		assert.NoError(t, err, bucket)
+	}
+
+	// Creating the buckets again leaves them unchanged
+	err = CreateBuckets(js, "test")
+	require.NoError(t, err)
+}
@@ -0,0 +1,197 @@
+package natskv
+
+import (
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+type jetstreamBindingRecord struct {
+	ID                    uuid.UUID                   `json:"id"`
+	TargetType            repositories.TargetType     `json:"target_type"`
+	TargetURI             string                      `json:"target_uri"`
+	TargetQualifier       string                      `json:"target_qualifier"`
+	WebhookHeaders        map[string]string           `json:"webhook_headers,omitempty"`
+	WebhookSigningSecret  string                      `json:"webhook_signing_secret,omitempty"`
+	WebhookTimeout        time.Duration               `json:"webhook_timeout,omitempty"`
+	WebhookInsecure       bool                        `json:"webhook_insecure,omitempty"`
+	LambdaARN             string                      `json:"lambda_arn,omitempty"`
+	Stream                string                      `json:"nats_stream"`
+	Consumer              uuid.UUID                   `json:"nats_consumer"`
+	SubjectPattern        string                      `json:"nats_subject_pattern"`
+	MaxMessages           int                         `json:"max_messages"`
+	MaxLatency            time.Duration               `json:"max_latency"`
+	DeliveryPolicy        repositories.DeliveryPolicy `json:"delivery_policy"`
+	MaxDeliveries         int                         `json:"max_deliveries"`
+	DeadLetterSubject     string                      `json:"dead_letter_subject"`
+	RetryInitialDelay     time.Duration               `json:"retry_initial_delay"`
+	RetryMultiplier       float64                     `json:"retry_multiplier"`
+	RetryMaxDelay         time.Duration               `json:"retry_max_delay"`
+	RetryJitter           float64                     `json:"retry_jitter"`
+	InvocationType        repositories.InvocationType `json:"invocation_type"`
+	PayloadFormat         repositories.PayloadFormat  `json:"payload_format"`
+	MaxConcurrency        int                         `json:"max_concurrency"`
+	PartitionSubjectToken int                         `json:"partition_subject_token"`
+	PartitionHeader       string                      `json:"partition_header"`
+	Paused                bool                        `json:"paused"`
+	RequiredLabels        repositories.Labels         `json:"required_labels,omitempty"`
+	CreatedAt             time.Time                   `json:"created_at"`
+	UpdatedAt             time.Time                   `json:"updated_at"`
+}
+
+func (r *jetstreamBindingRecord) toJetstreamBinding(peers []repositories.Peer) *repositories.JetstreamBinding {
+	binding := &repositories.JetstreamBinding{
+		ID:             r.ID,
+		Target:         r.target(),
+		Stream:         r.Stream,
+		Consumer:       r.Consumer,
+		Subject:        r.SubjectPattern,
+		MaxMessages:    r.MaxMessages,
+		MaxLatency:     r.MaxLatency,
+		DeliveryPolicy: r.DeliveryPolicy,
+
+		MaxDeliveries:     r.MaxDeliveries,
+		DeadLetterSubject: r.DeadLetterSubject,
+		RetryPolicy: repositories.RetryPolicy{
+			InitialDelay: r.RetryInitialDelay,
+			Multiplier:   r.RetryMultiplier,
+			MaxDelay:     r.RetryMaxDelay,
+			Jitter:       r.RetryJitter,
+		},
+		InvocationType: r.InvocationType,
+		PayloadFormat:  r.PayloadFormat,
+		MaxConcurrency: r.MaxConcurrency,
+		PartitionKey: repositories.PartitionKey{
+			SubjectToken: r.PartitionSubjectToken,
+			Header:       r.PartitionHeader,
+		},
+		Paused:         r.Paused,
+		RequiredLabels: r.RequiredLabels,
+	}
+	binding.AssignedPeerID = repositories.AssignPeer(*binding, peers)
+
+	return binding
+}
+
+// target returns the target of the binding, which records written before
+// bindings had target types give as just the ARN of a Lambda.
+func (r *jetstreamBindingRecord) target() repositories.Target {
+	if r.TargetURI == "" && r.LambdaARN != "" {
+		return repositories.Target{Type: repositories.TargetLambda, URI: r.LambdaARN}
+	}
+
+	return repositories.Target{
+		Type:      r.TargetType,
+		URI:       r.TargetURI,
+		Qualifier: r.TargetQualifier,
+		Webhook: repositories.WebhookConfig{
+			Headers:       r.WebhookHeaders,
+			SigningSecret: r.WebhookSigningSecret,
+			Timeout:       r.WebhookTimeout,
+			Insecure:      r.WebhookInsecure,
+		},
+	}
+}
+
+func (r *jetstreamBindingRecord) applyUpdate(update *repositories.UpdateJetstreamBinding) {
+	r.UpdatedAt = time.Now()
+
+	if update.Target != nil {
+		r.TargetType = update.Target.Type
+		r.TargetURI = update.Target.URI
+		r.TargetQualifier = update.Target.Qualifier
+		r.WebhookHeaders = update.Target.Webhook.Headers
+		r.WebhookSigningSecret = update.Target.Webhook.SigningSecret
+		r.WebhookTimeout = update.Target.Webhook.Timeout
+		r.WebhookInsecure = update.Target.Webhook.Insecure
+		r.LambdaARN = ""
+	}
+
+	if update.Subject != nil {
+		r.SubjectPattern = *update.Subject
+	}
+
+	if update.MaxMessages != nil {
+		r.MaxMessages = *update.MaxMessages
+	}
+
+	if update.MaxLatency != nil {
+		r.MaxLatency = *update.MaxLatency
+	}
+
+	if update.MaxDeliveries != nil {
+		r.MaxDeliveries = *update.MaxDeliveries
+	}
+
+	if update.DeadLetterSubject != nil {
+		r.DeadLetterSubject = *update.DeadLetterSubject
+	}
+
+	if update.RetryPolicy != nil {
+		r.RetryInitialDelay = update.RetryPolicy.InitialDelay
+		r.RetryMultiplier = update.RetryPolicy.Multiplier
+		r.RetryMaxDelay = update.RetryPolicy.MaxDelay
+		r.RetryJitter = update.RetryPolicy.Jitter
+	}
+
+	if update.InvocationType != nil {
+		r.InvocationType = *update.InvocationType
+	}
+
+	if update.PayloadFormat != nil {
+		r.PayloadFormat = *update.PayloadFormat
+	}
+
+	if update.MaxConcurrency != nil {
+		r.MaxConcurrency = *update.MaxConcurrency
+	}
+
+	if update.PartitionKey != nil {
+		r.PartitionSubjectToken = update.PartitionKey.SubjectToken
+		r.PartitionHeader = update.PartitionKey.Header
+	}
+
+	if update.Paused != nil {
+		r.Paused = *update.Paused
+	}
+
+	if update.RequiredLabels != nil {
+		r.RequiredLabels = *update.RequiredLabels
+	}
+}
+
+func newJetstreamBinding(create *repositories.CreateJetstreamBinding) *jetstreamBindingRecord {
+	id := uuid.New()
+
+	return &jetstreamBindingRecord{
+		ID:                    id,
+		TargetType:            create.Target.Type,
+		TargetURI:             create.Target.URI,
+		TargetQualifier:       create.Target.Qualifier,
+		WebhookHeaders:        create.Target.Webhook.Headers,
+		WebhookSigningSecret:  create.Target.Webhook.SigningSecret,
+		WebhookTimeout:        create.Target.Webhook.Timeout,
+		WebhookInsecure:       create.Target.Webhook.Insecure,
+		Stream:                create.Stream,
+		Consumer:              id,
+		SubjectPattern:        create.Subject,
+		MaxMessages:           create.MaxMessages,
+		MaxLatency:            create.MaxLatency,
+		DeliveryPolicy:        create.DeliveryPolicy,
+		MaxDeliveries:         create.MaxDeliveries,
+		DeadLetterSubject:     create.DeadLetterSubject,
+		RetryInitialDelay:     create.RetryPolicy.InitialDelay,
+		RetryMultiplier:       create.RetryPolicy.Multiplier,
+		RetryMaxDelay:         create.RetryPolicy.MaxDelay,
+		RetryJitter:           create.RetryPolicy.Jitter,
+		InvocationType:        create.InvocationType,
+		PayloadFormat:         create.PayloadFormat,
+		MaxConcurrency:        create.MaxConcurrency,
+		PartitionSubjectToken: create.PartitionKey.SubjectToken,
+		PartitionHeader:       create.PartitionKey.Header,
+		RequiredLabels:        create.RequiredLabels,
+		CreatedAt:             time.Now(),
+		UpdatedAt:             time.Now(),
+	}
+}
@@ -0,0 +1,24 @@
+package natskv
+
+import (
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+type leaseRecord struct {
+	BindingID uuid.UUID `json:"binding_id"`
+	PeerID    uuid.UUID `json:"peer_id"`
+	Token     int64     `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (r *leaseRecord) toLease() *repositories.Lease {
+	return &repositories.Lease{
+		BindingID: r.BindingID,
+		PeerID:    r.PeerID,
+		Token:     r.Token,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
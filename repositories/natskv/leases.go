@@ -0,0 +1,102 @@
+package natskv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+var _ repositories.Leases = (*Leases)(nil)
+
+type Leases struct {
+	kv nats.KeyValue
+}
+
+func (l *Leases) AcquireLease(ctx context.Context, bindingID, peerID uuid.UUID, ttl time.Duration) (*repositories.Lease, error) {
+	now := time.Now()
+
+	record, revision, err := getRecord[leaseRecord](l.kv, bindingID.String())
+	switch {
+	case errors.Is(err, nats.ErrKeyNotFound):
+		record = &leaseRecord{BindingID: bindingID}
+	case err != nil:
+		return nil, fmt.Errorf("failed to acquire lease: %w", err)
+	case record.PeerID != peerID && record.ExpiresAt.After(now):
+		return nil, repositories.ErrLeaseHeld
+	}
+
+	record.PeerID = peerID
+	record.Token++
+	record.ExpiresAt = now.Add(ttl)
+
+	// Only one of any peers racing to acquire the lease writes this revision
+	if _, err := putRecord(l.kv, bindingID.String(), record, revision); err != nil {
+		if errors.Is(err, nats.ErrKeyExists) {
+			return nil, repositories.ErrLeaseHeld
+		}
+
+		return nil, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	return record.toLease(), nil
+}
+
+func (l *Leases) RenewLease(ctx context.Context, lease repositories.Lease, ttl time.Duration) (*repositories.Lease, error) {
+	record, err := l.update(lease, time.Now().Add(ttl))
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew lease: %w", err)
+	}
+
+	return record.toLease(), nil
+}
+
+func (l *Leases) ReleaseLease(ctx context.Context, lease repositories.Lease) error {
+	// The record is kept, rather than deleted, so that fencing tokens keep
+	// increasing for the next holder
+	if _, err := l.update(lease, time.Now()); err != nil {
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+
+	return nil
+}
+
+// update sets the expiry of a lease, provided it is still held.
+func (l *Leases) update(lease repositories.Lease, expiresAt time.Time) (*leaseRecord, error) {
+	record, revision, err := getRecord[leaseRecord](l.kv, lease.BindingID.String())
+	switch {
+	case errors.Is(err, nats.ErrKeyNotFound):
+		return nil, repositories.ErrLeaseLost
+	case err != nil:
+		return nil, err
+	case record.PeerID != lease.PeerID || record.Token != lease.Token:
+		return nil, repositories.ErrLeaseLost
+	}
+
+	record.ExpiresAt = expiresAt
+
+	if _, err := putRecord(l.kv, lease.BindingID.String(), record, revision); err != nil {
+		if errors.Is(err, nats.ErrKeyExists) {
+			return nil, repositories.ErrLeaseLost
+		}
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func NewLeases(js nats.JetStreamContext, bucketPrefix string) (*Leases, error) {
+	kv, err := openBucket(js, bucketPrefix, leasesBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Leases{
+		kv: kv,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package natskv
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNewLeases(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	leases, err := NewLeases(js, "test")
+	require.NoError(t, err)
+	require.NotNil(t, leases)
+}
+
+func TestLeasesConformance(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	leases, err := NewLeases(js, "test")
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewLeasesConformanceSuite(leases))
+}
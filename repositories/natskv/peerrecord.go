@@ -0,0 +1,30 @@
+package natskv
+
+import (
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+type peerRecord struct {
+	ID          uuid.UUID           `json:"id"`
+	Name        string              `json:"name"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	DeleteAfter time.Time           `json:"delete_after"`
+	Weight      float64             `json:"weight"`
+	Labels      repositories.Labels `json:"labels,omitempty"`
+}
+
+func (r *peerRecord) toPeer() *repositories.Peer {
+	return &repositories.Peer{
+		ID:             r.ID,
+		Hostname:       r.Name,
+		JoinedAt:       r.CreatedAt,
+		LastSeenAt:     r.UpdatedAt,
+		HeartbeatDueBy: r.DeleteAfter,
+		Weight:         r.Weight,
+		Labels:         r.Labels,
+	}
+}
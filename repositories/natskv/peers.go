@@ -0,0 +1,104 @@
+package natskv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+var _ repositories.Peers = (*Peers)(nil)
+
+type Peers struct {
+	kv      nats.KeyValue
+	peerTTL time.Duration
+}
+
+func (s *Peers) JoinPeers(ctx context.Context, join *repositories.JoinPeer) (*repositories.Peer, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	record := &peerRecord{
+		ID:          uuid.New(),
+		Name:        hostname,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		DeleteAfter: time.Now().Add(s.peerTTL),
+		Weight:      join.Weight,
+		Labels:      join.Labels,
+	}
+
+	if _, err := putRecord(s.kv, record.ID.String(), record, 0); err != nil {
+		return nil, err
+	}
+
+	return record.toPeer(), nil
+}
+
+func (s *Peers) SendHeartbeat(ctx context.Context, id uuid.UUID) (*repositories.Peer, error) {
+	record, err := updateRecord(s.kv, id.String(), func(record *peerRecord) error {
+		record.UpdatedAt = time.Now()
+		record.DeleteAfter = time.Now().Add(s.peerTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return record.toPeer(), nil
+}
+
+func (s *Peers) LeavePeers(ctx context.Context, id uuid.UUID) error {
+	return s.kv.Delete(id.String())
+}
+
+func (s *Peers) ListPeers(ctx context.Context) ([]repositories.Peer, error) {
+	return listPeers(s.kv)
+}
+
+// listPeers returns the peers that are due to send a heartbeat. Peers that
+// have missed theirs are expired from the bucket, but may not be yet.
+func listPeers(kv nats.KeyValue) ([]repositories.Peer, error) {
+	keys, err := keys(kv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list peers: %w", err)
+	}
+
+	var peers []repositories.Peer
+	for _, key := range keys {
+		record, _, err := getRecord[peerRecord](kv, key)
+		if err != nil {
+			// The peer left, or expired, after the keys were listed
+			if errors.Is(err, nats.ErrKeyNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if record.DeleteAfter.After(time.Now()) {
+			peers = append(peers, *record.toPeer())
+		}
+	}
+
+	return peers, nil
+}
+
+func NewPeers(js nats.JetStreamContext, bucketPrefix string) (*Peers, error) {
+	kv, err := openBucket(js, bucketPrefix, peersBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Peers{
+		kv:      kv,
+		peerTTL: peerTTL,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package natskv
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNewPeers(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	peers, err := NewPeers(js, "test")
+	require.NoError(t, err)
+	require.NotNil(t, peers)
+}
+
+func TestPeersConformance(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	peers, err := NewPeers(js, "test")
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewPeersConformanceSuite(peers))
+}
@@ -0,0 +1,83 @@
+package natskv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+var _ repositories.Stats = (*Stats)(nil)
+
+type Stats struct {
+	kv nats.KeyValue
+}
+
+func (s *Stats) RecordBindingStats(ctx context.Context, stats *repositories.BindingStats) error {
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		record, revision, err := getRecord[bindingStatsRecord](s.kv, stats.BindingID.String())
+		switch {
+		case errors.Is(err, nats.ErrKeyNotFound):
+			record = &bindingStatsRecord{BindingID: stats.BindingID}
+		case err != nil:
+			return fmt.Errorf("failed to record binding stats: %w", err)
+		}
+
+		if stats.LeaseToken < record.LeaseToken {
+			return repositories.ErrLeaseLost
+		}
+
+		record.PeerID = stats.PeerID
+		record.LeaseToken = stats.LeaseToken
+		record.RunningUntil = stats.RunningUntil
+		record.UpdatedAt = stats.RecordedAt
+
+		if !stats.LastSuccessAt.IsZero() {
+			record.LastSuccessAt = stats.LastSuccessAt
+		}
+
+		if !stats.LastErrorAt.IsZero() {
+			record.LastErrorAt = stats.LastErrorAt
+			record.LastError = stats.LastError
+		}
+
+		if _, err := putRecord(s.kv, stats.BindingID.String(), record, revision); err != nil {
+			if errors.Is(err, nats.ErrKeyExists) {
+				continue
+			}
+
+			return fmt.Errorf("failed to record binding stats: %w", err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("failed to record binding stats: too many concurrent writes")
+}
+
+func (s *Stats) GetBindingStats(ctx context.Context, bindingID uuid.UUID) (*repositories.BindingStats, error) {
+	record, _, err := getRecord[bindingStatsRecord](s.kv, bindingID.String())
+	if err != nil {
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return record.toBindingStats(), nil
+}
+
+func NewStats(js nats.JetStreamContext, bucketPrefix string) (*Stats, error) {
+	kv, err := openBucket(js, bucketPrefix, statsBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stats{
+		kv: kv,
+	}, nil
+}
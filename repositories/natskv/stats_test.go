@@ -0,0 +1,32 @@
+package natskv
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNewStats(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	stats, err := NewStats(js, "test")
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+}
+
+func TestStatsConformance(t *testing.T) {
+	js := testingNATS(t)
+
+	err := CreateBuckets(js, "test")
+	require.NoError(t, err)
+
+	stats, err := NewStats(js, "test")
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewStatsConformanceSuite(stats))
+}
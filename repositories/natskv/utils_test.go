@@ -0,0 +1,55 @@
+package natskv
+
+import (
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/ory/dockertest/v3"
+)
+
+func testingNATS(t *testing.T) nats.JetStreamContext {
+	t.Helper()
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		t.Fatalf("Could construct docker pool: %s", err)
+	}
+
+	if err := pool.Client.Ping(); err != nil {
+		t.Fatalf("Could not connect to Docker: %s", err)
+	}
+
+	natsContainer, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "nats",
+		Tag:        "latest",
+		Cmd:        []string{"-js"},
+	})
+	if err != nil {
+		t.Fatalf("Could not start NATS container: %s", err)
+	}
+
+	if err := pool.Retry(func() error {
+		nc, err := nats.Connect(natsContainer.GetHostPort("4222/tcp"))
+		if err != nil {
+			return err
+		}
+		defer nc.Close()
+
+		return nil
+	}); err != nil {
+		t.Fatalf("Could not connect to NATS: %s", err)
+	}
+
+	nc, err := nats.Connect(natsContainer.GetHostPort("4222/tcp"))
+	if err != nil {
+		t.Fatalf("Could not connect to NATS: %s", err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatalf("Could not connect to JetStream: %s", err)
+	}
+
+	return js
+}
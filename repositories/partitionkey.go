@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"strings"
+
+	"github.com/JoeReid/jetbridge"
+)
+
+// PartitionKey selects the value a binding's messages are partitioned by.
+// Messages sharing a key are dispatched in stream order, while messages with
+// different keys may be dispatched in parallel.
+//
+// SubjectToken is the 1-based index of a token of the message subject, so 2
+// partitions `orders.<customerID>.*` by customer. Header names a message
+// header instead. Messages missing the token or header share the empty key.
+//
+// The zero value disables partitioning.
+type PartitionKey struct {
+	SubjectToken int
+	Header       string
+}
+
+// IsZero reports whether partitioning is disabled.
+func (p PartitionKey) IsZero() bool {
+	return p.SubjectToken <= 0 && p.Header == ""
+}
+
+// Key returns the partition the message belongs to.
+func (p PartitionKey) Key(payload jetbridge.JetstreamLambdaPayload) string {
+	if p.SubjectToken > 0 {
+		tokens := strings.Split(payload.Subject, ".")
+		if p.SubjectToken > len(tokens) {
+			return ""
+		}
+
+		return tokens[p.SubjectToken-1]
+	}
+
+	if p.Header != "" {
+		return payload.Header.Get(p.Header)
+	}
+
+	return ""
+}
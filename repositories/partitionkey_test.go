@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionKey_Key(t *testing.T) {
+	payload := jetbridge.JetstreamLambdaPayload{
+		Subject: "orders.customer-1.created",
+		Header:  nats.Header{"Customer-Id": []string{"customer-2"}},
+	}
+
+	tests := []struct {
+		name     string
+		key      PartitionKey
+		payload  jetbridge.JetstreamLambdaPayload
+		expected string
+	}{
+		{name: "zero value", key: PartitionKey{}, payload: payload, expected: ""},
+		{name: "first subject token", key: PartitionKey{SubjectToken: 1}, payload: payload, expected: "orders"},
+		{name: "subject token", key: PartitionKey{SubjectToken: 2}, payload: payload, expected: "customer-1"},
+		{name: "missing subject token", key: PartitionKey{SubjectToken: 4}, payload: payload, expected: ""},
+		{name: "header", key: PartitionKey{Header: "Customer-Id"}, payload: payload, expected: "customer-2"},
+		{name: "missing header", key: PartitionKey{Header: "Tenant-Id"}, payload: payload, expected: ""},
+		{name: "no headers", key: PartitionKey{Header: "Customer-Id"}, payload: jetbridge.JetstreamLambdaPayload{Subject: "orders"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.key.Key(tt.payload))
+		})
+	}
+}
+
+func TestPartitionKey_IsZero(t *testing.T) {
+	assert.True(t, PartitionKey{}.IsZero())
+	assert.False(t, PartitionKey{SubjectToken: 1}.IsZero())
+	assert.False(t, PartitionKey{Header: "Customer-Id"}.IsZero())
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/JoeReid/jetbridge"
+)
+
+// PayloadFormat selects how a binding's messages are encoded in the requests
+// made to its target. The jetbridge package has types for each format.
+//
+// The zero value is equivalent to PayloadFormatJetstream.
+type PayloadFormat string
+
+const (
+	// PayloadFormatJetstream encodes messages as a
+	// jetbridge.JetstreamLambdaPayload, or a
+	// jetbridge.JetstreamBatchedLambdaPayload for batched bindings.
+	PayloadFormatJetstream PayloadFormat = "jetstream"
+	// PayloadFormatJSON encodes messages as a jetbridge.JSONMessage, or a
+	// jetbridge.JSONMessageBatch for batched bindings.
+	PayloadFormatJSON PayloadFormat = "json"
+	// PayloadFormatCloudEvents encodes messages as a jetbridge.CloudEvent, or
+	// a jetbridge.CloudEventBatch for batched bindings.
+	PayloadFormatCloudEvents PayloadFormat = "cloudevents"
+	// PayloadFormatRaw sends the message data unchanged, and so cannot be
+	// used by batched bindings.
+	PayloadFormatRaw PayloadFormat = "raw"
+)
+
+// ErrRawBatch is returned when batching a binding with PayloadFormatRaw.
+var ErrRawBatch = errors.New("raw payload format cannot be used by batched bindings")
+
+// Encode returns the body of a request delivering a single message, and its
+// content type.
+func (f PayloadFormat) Encode(payload jetbridge.JetstreamLambdaPayload) ([]byte, string, error) {
+	var v any
+	switch f {
+	case "", PayloadFormatJetstream:
+		v = payload
+	case PayloadFormatJSON:
+		v = jetbridge.NewJSONMessage(payload)
+	case PayloadFormatCloudEvents:
+		body, err := json.Marshal(jetbridge.NewCloudEvent(payload))
+		return body, jetbridge.ContentTypeCloudEvent, err
+	case PayloadFormatRaw:
+		return payload.Data, jetbridge.RawContentType(payload), nil
+	default:
+		return nil, "", fmt.Errorf("unknown payload format %q", f)
+	}
+
+	body, err := json.Marshal(v)
+	return body, jetbridge.ContentTypeJSON, err
+}
+
+// EncodeBatch returns the body of a request delivering a batch of messages,
+// and its content type.
+func (f PayloadFormat) EncodeBatch(batch jetbridge.JetstreamBatchedLambdaPayload) ([]byte, string, error) {
+	var v any
+	switch f {
+	case "", PayloadFormatJetstream:
+		v = batch
+	case PayloadFormatJSON:
+		v = jetbridge.NewJSONMessageBatch(batch)
+	case PayloadFormatCloudEvents:
+		body, err := json.Marshal(jetbridge.NewCloudEventBatch(batch))
+		return body, jetbridge.ContentTypeCloudEventBatch, err
+	case PayloadFormatRaw:
+		return nil, "", ErrRawBatch
+	default:
+		return nil, "", fmt.Errorf("unknown payload format %q", f)
+	}
+
+	body, err := json.Marshal(v)
+	return body, jetbridge.ContentTypeJSON, err
+}
@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testingPayload(sequence uint64, contentType string, data string) jetbridge.JetstreamLambdaPayload {
+	header := nats.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	return jetbridge.JetstreamLambdaPayload{
+		Subject: "orders.created",
+		Header:  header,
+		Data:    []byte(data),
+		Metadata: nats.MsgMetadata{
+			Sequence:     nats.SequencePair{Stream: sequence, Consumer: 7},
+			NumDelivered: 2,
+			NumPending:   3,
+			Timestamp:    time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC),
+			Stream:       "ORDERS",
+			Consumer:     "my-consumer",
+		},
+	}
+}
+
+func TestPayloadFormat_Encode(t *testing.T) {
+	payload := testingPayload(1042, "application/json", `{"id":42}`)
+
+	t.Run("jetstream", func(t *testing.T) {
+		for _, format := range []PayloadFormat{"", PayloadFormatJetstream} {
+			body, contentType, err := format.Encode(payload)
+			require.NoError(t, err)
+			assert.Equal(t, jetbridge.ContentTypeJSON, contentType)
+
+			var got jetbridge.JetstreamLambdaPayload
+			require.NoError(t, json.Unmarshal(body, &got))
+			assert.Equal(t, payload.Data, got.Data)
+			assert.Equal(t, payload.Metadata.Sequence, got.Metadata.Sequence)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		body, contentType, err := PayloadFormatJSON.Encode(payload)
+		require.NoError(t, err)
+		assert.Equal(t, jetbridge.ContentTypeJSON, contentType)
+
+		assert.JSONEq(t, `{
+			"subject": "orders.created",
+			"headers": {"Content-Type": ["application/json"]},
+			"data": "eyJpZCI6NDJ9",
+			"stream": "ORDERS",
+			"consumer": "my-consumer",
+			"stream_sequence": 1042,
+			"consumer_sequence": 7,
+			"num_delivered": 2,
+			"num_pending": 3,
+			"timestamp": "2023-04-01T12:00:00Z"
+		}`, string(body))
+	})
+
+	t.Run("cloudevents", func(t *testing.T) {
+		body, contentType, err := PayloadFormatCloudEvents.Encode(payload)
+		require.NoError(t, err)
+		assert.Equal(t, jetbridge.ContentTypeCloudEvent, contentType)
+
+		assert.JSONEq(t, `{
+			"specversion": "1.0",
+			"id": "1042",
+			"source": "/jetstream/ORDERS",
+			"type": "io.github.joereid.jetbridge.message",
+			"subject": "orders.created",
+			"time": "2023-04-01T12:00:00Z",
+			"datacontenttype": "application/json",
+			"data": {"id": 42},
+			"natsstream": "ORDERS",
+			"natsconsumer": "my-consumer",
+			"natssequence": "1042",
+			"natsdelivered": 2
+		}`, string(body))
+
+		var got jetbridge.CloudEvent
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, uint64(1042), got.NATSSequence)
+		assert.JSONEq(t, `{"id":42}`, string(got.Bytes()))
+	})
+
+	t.Run("cloudevents binary data", func(t *testing.T) {
+		body, _, err := PayloadFormatCloudEvents.Encode(testingPayload(1, "text/plain", "hello"))
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "text/plain", got["datacontenttype"])
+		assert.Equal(t, "aGVsbG8=", got["data_base64"])
+		assert.NotContains(t, got, "data")
+
+		var event jetbridge.CloudEvent
+		require.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, []byte("hello"), event.Bytes())
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		body, contentType, err := PayloadFormatRaw.Encode(payload)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", contentType)
+		assert.Equal(t, payload.Data, body)
+
+		_, contentType, err = PayloadFormatRaw.Encode(testingPayload(1, "", "hello"))
+		require.NoError(t, err)
+		assert.Equal(t, jetbridge.ContentTypeOctetStream, contentType)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, _, err := PayloadFormat("xml").Encode(payload)
+		assert.Error(t, err)
+	})
+}
+
+func TestPayloadFormat_EncodeBatch(t *testing.T) {
+	batch := jetbridge.JetstreamBatchedLambdaPayload{
+		testingPayload(1, "", "first"),
+		testingPayload(2, "", "second"),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		body, contentType, err := PayloadFormatJSON.EncodeBatch(batch)
+		require.NoError(t, err)
+		assert.Equal(t, jetbridge.ContentTypeJSON, contentType)
+
+		var got jetbridge.JSONMessageBatch
+		require.NoError(t, json.Unmarshal(body, &got))
+		require.Len(t, got.Messages, 2)
+		assert.Equal(t, uint64(2), got.Messages[1].StreamSequence)
+		assert.Equal(t, []byte("second"), got.Messages[1].Data)
+	})
+
+	t.Run("cloudevents", func(t *testing.T) {
+		body, contentType, err := PayloadFormatCloudEvents.EncodeBatch(batch)
+		require.NoError(t, err)
+		assert.Equal(t, jetbridge.ContentTypeCloudEventBatch, contentType)
+
+		var got jetbridge.CloudEventBatch
+		require.NoError(t, json.Unmarshal(body, &got))
+		require.Len(t, got, 2)
+		assert.Equal(t, "2", got[1].ID)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		_, _, err := PayloadFormatRaw.EncodeBatch(batch)
+		assert.ErrorIs(t, err, ErrRawBatch)
+	})
+}
@@ -10,7 +10,7 @@ import (
 //go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_peers.go -package=mocks github.com/JoeReid/jetbridge/repositories Peers
 
 type Peers interface {
-	JoinPeers(ctx context.Context) (*Peer, error)
+	JoinPeers(ctx context.Context, join *JoinPeer) (*Peer, error)
 	SendHeartbeat(ctx context.Context, id uuid.UUID) (*Peer, error)
 	LeavePeers(ctx context.Context, id uuid.UUID) error
 
@@ -23,4 +23,15 @@ type Peer struct {
 	JoinedAt       time.Time
 	LastSeenAt     time.Time
 	HeartbeatDueBy time.Time
+
+	// Weight is the capacity of the peer relative to the others, which are
+	// assigned bindings in proportion to their weight.
+	Weight float64
+	Labels Labels
+}
+
+// JoinPeer describes the peer joining the cluster.
+type JoinPeer struct {
+	Weight float64
+	Labels Labels
 }
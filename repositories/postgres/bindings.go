@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.Bindings = (*Bindings)(nil)
+
+type Bindings struct {
+	db *sql.DB
+}
+
+const bindingColumns = `id, target_type, target_uri, target_qualifier, webhook_headers, webhook_signing_secret, webhook_timeout, webhook_insecure, nats_stream, nats_consumer, nats_subject_pattern, max_messages, max_latency,
+	delivery_policy, max_deliveries, dead_letter_subject, retry_initial_delay, retry_multiplier, retry_max_delay,
+	retry_jitter, invocation_type, payload_format, max_concurrency, partition_subject_token, partition_header, paused, required_labels`
+
+func scanBinding(row interface{ Scan(...any) error }) (*repositories.JetstreamBinding, error) {
+	var (
+		binding        repositories.JetstreamBinding
+		deliveryPolicy string
+		webhookHeaders labels
+		requiredLabels labels
+	)
+
+	if err := row.Scan(
+		&binding.ID,
+		&binding.Target.Type,
+		&binding.Target.URI,
+		&binding.Target.Qualifier,
+		&webhookHeaders,
+		&binding.Target.Webhook.SigningSecret,
+		&binding.Target.Webhook.Timeout,
+		&binding.Target.Webhook.Insecure,
+		&binding.Stream,
+		&binding.Consumer,
+		&binding.Subject,
+		&binding.MaxMessages,
+		&binding.MaxLatency,
+		&deliveryPolicy,
+		&binding.MaxDeliveries,
+		&binding.DeadLetterSubject,
+		&binding.RetryPolicy.InitialDelay,
+		&binding.RetryPolicy.Multiplier,
+		&binding.RetryPolicy.MaxDelay,
+		&binding.RetryPolicy.Jitter,
+		&binding.InvocationType,
+		&binding.PayloadFormat,
+		&binding.MaxConcurrency,
+		&binding.PartitionKey.SubjectToken,
+		&binding.PartitionKey.Header,
+		&binding.Paused,
+		&requiredLabels,
+	); err != nil {
+		return nil, err
+	}
+
+	policy, err := repositories.ParseDeliveryPolicy(deliveryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	binding.DeliveryPolicy = policy
+	binding.Target.Webhook.Headers = webhookHeaders
+	binding.RequiredLabels = repositories.Labels(requiredLabels)
+	return &binding, nil
+}
+
+// bindingValues returns the values of bindingColumns for a binding.
+func bindingValues(binding *repositories.JetstreamBinding) []any {
+	return []any{
+		binding.ID,
+		binding.Target.Type,
+		binding.Target.URI,
+		binding.Target.Qualifier,
+		labels(binding.Target.Webhook.Headers),
+		binding.Target.Webhook.SigningSecret,
+		binding.Target.Webhook.Timeout,
+		binding.Target.Webhook.Insecure,
+		binding.Stream,
+		binding.Consumer,
+		binding.Subject,
+		binding.MaxMessages,
+		binding.MaxLatency,
+		binding.DeliveryPolicy.String(),
+		binding.MaxDeliveries,
+		binding.DeadLetterSubject,
+		binding.RetryPolicy.InitialDelay,
+		binding.RetryPolicy.Multiplier,
+		binding.RetryPolicy.MaxDelay,
+		binding.RetryPolicy.Jitter,
+		binding.InvocationType,
+		binding.PayloadFormat,
+		binding.MaxConcurrency,
+		binding.PartitionKey.SubjectToken,
+		binding.PartitionKey.Header,
+		binding.Paused,
+		labels(binding.RequiredLabels),
+	}
+}
+
+func (b *Bindings) CreateJetstreamBinding(ctx context.Context, create *repositories.CreateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	id := uuid.New()
+
+	binding := &repositories.JetstreamBinding{
+		ID:                id,
+		Target:            create.Target,
+		Stream:            create.Stream,
+		Consumer:          id,
+		Subject:           create.Subject,
+		MaxMessages:       create.MaxMessages,
+		MaxLatency:        create.MaxLatency,
+		DeliveryPolicy:    create.DeliveryPolicy,
+		MaxDeliveries:     create.MaxDeliveries,
+		DeadLetterSubject: create.DeadLetterSubject,
+		RetryPolicy:       create.RetryPolicy,
+		InvocationType:    create.InvocationType,
+		PayloadFormat:     create.PayloadFormat,
+		MaxConcurrency:    create.MaxConcurrency,
+		PartitionKey:      create.PartitionKey,
+		RequiredLabels:    create.RequiredLabels,
+	}
+
+	now := time.Now()
+	if _, err := b.db.ExecContext(ctx, `
+		INSERT INTO bindings (`+bindingColumns+`, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $28)`,
+		append(bindingValues(binding), now)...,
+	); err != nil {
+		return nil, fmt.Errorf("failed to create jetstream binding: %w", err)
+	}
+
+	return b.assign(ctx, binding)
+}
+
+func (b *Bindings) GetJetstreamBinding(ctx context.Context, id uuid.UUID) (*repositories.JetstreamBinding, error) {
+	binding, err := scanBinding(b.db.QueryRowContext(ctx, `SELECT `+bindingColumns+` FROM bindings WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+		}
+
+		return nil, err
+	}
+
+	return b.assign(ctx, binding)
+}
+
+func (b *Bindings) ListJetstreamBindings(ctx context.Context) ([]repositories.JetstreamBinding, error) {
+	peers, err := listPeers(ctx, b.db)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := b.db.QueryContext(ctx, `SELECT `+bindingColumns+` FROM bindings ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bindings []repositories.JetstreamBinding
+	for rows.Next() {
+		binding, err := scanBinding(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		binding.AssignedPeerID = repositories.AssignPeer(*binding, peers)
+		bindings = append(bindings, *binding)
+	}
+
+	return bindings, rows.Err()
+}
+
+func (b *Bindings) UpdateJetstreamBinding(ctx context.Context, id uuid.UUID, update *repositories.UpdateJetstreamBinding) (*repositories.JetstreamBinding, error) {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Lock the row so that concurrent updates of different fields both apply
+	binding, err := scanBinding(tx.QueryRowContext(ctx, `SELECT `+bindingColumns+` FROM bindings WHERE id = $1 FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, repositories.ErrBindingNotFound)
+		}
+
+		return nil, fmt.Errorf("failed to update jetstream binding: %w", err)
+	}
+
+	if err := binding.ValidateUpdate(update); err != nil {
+		return nil, fmt.Errorf("failed to update jetstream binding %s: %w", id, err)
+	}
+
+	binding.Apply(update)
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE bindings SET (`+bindingColumns+`, updated_at)
+		= ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28)
+		WHERE id = $1`,
+		append(bindingValues(binding), time.Now())...,
+	); err != nil {
+		return nil, fmt.Errorf("failed to update jetstream binding: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to update jetstream binding: %w", err)
+	}
+
+	return b.assign(ctx, binding)
+}
+
+func (b *Bindings) DeleteJetstreamBinding(ctx context.Context, id uuid.UUID) error {
+	_, err := b.db.ExecContext(ctx, `DELETE FROM bindings WHERE id = $1`, id)
+	return err
+}
+
+// assign sets the peer the binding is assigned to.
+func (b *Bindings) assign(ctx context.Context, binding *repositories.JetstreamBinding) (*repositories.JetstreamBinding, error) {
+	peers, err := listPeers(ctx, b.db)
+	if err != nil {
+		return nil, err
+	}
+
+	binding.AssignedPeerID = repositories.AssignPeer(*binding, peers)
+	return binding, nil
+}
+
+func NewBindings(db *sql.DB) (*Bindings, error) {
+	if err := checkSchema(db); err != nil {
+		return nil, err
+	}
+
+	return &Bindings{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBindingsConformance(t *testing.T) {
+	db := testingPostgres(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := Migrate(ctx, db)
+	require.NoError(t, err)
+
+	peers, err := NewPeers(db)
+	require.NoError(t, err)
+
+	bindings, err := NewBindings(db)
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewBindingsConformanceSuite(peers, bindings))
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JoeReid/jetbridge/repositories"
+)
+
+// labels stores repositories.Labels, or any other string map, such as webhook
+// headers, in a jsonb column.
+type labels repositories.Labels
+
+func (l labels) Value() (driver.Value, error) {
+	if l == nil {
+		return "{}", nil
+	}
+
+	b, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (l *labels) Scan(src any) error {
+	var b []byte
+	switch src := src.(type) {
+	case []byte:
+		b = src
+	case string:
+		b = []byte(src)
+	default:
+		return fmt.Errorf("cannot scan %T into labels", src)
+	}
+
+	var decoded repositories.Labels
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return err
+	}
+
+	// Normalise the empty set, as stored for bindings without labels
+	if len(decoded) == 0 {
+		decoded = nil
+	}
+
+	*l = labels(decoded)
+	return nil
+}
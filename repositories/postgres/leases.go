@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.Leases = (*Leases)(nil)
+
+type Leases struct {
+	db *sql.DB
+}
+
+const leaseColumns = `binding_id, peer_id, token, expires_at`
+
+func scanLease(row *sql.Row) (*repositories.Lease, error) {
+	var lease repositories.Lease
+	if err := row.Scan(&lease.BindingID, &lease.PeerID, &lease.Token, &lease.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &lease, nil
+}
+
+func (l *Leases) AcquireLease(ctx context.Context, bindingID, peerID uuid.UUID, ttl time.Duration) (*repositories.Lease, error) {
+	now := time.Now()
+
+	// The conflicting row is locked by the upsert, so only one of any peers
+	// racing to acquire the lease sees it as expired
+	lease, err := scanLease(l.db.QueryRowContext(ctx, `
+		INSERT INTO leases (`+leaseColumns+`)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (binding_id) DO UPDATE
+		SET peer_id = excluded.peer_id, token = leases.token + 1, expires_at = excluded.expires_at
+		WHERE leases.expires_at <= $4 OR leases.peer_id = excluded.peer_id
+		RETURNING `+leaseColumns,
+		bindingID, peerID, now.Add(ttl), now,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrLeaseHeld
+		}
+
+		return nil, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	return lease, nil
+}
+
+func (l *Leases) RenewLease(ctx context.Context, lease repositories.Lease, ttl time.Duration) (*repositories.Lease, error) {
+	renewed, err := l.update(ctx, lease, time.Now().Add(ttl))
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew lease: %w", err)
+	}
+
+	return renewed, nil
+}
+
+func (l *Leases) ReleaseLease(ctx context.Context, lease repositories.Lease) error {
+	// The row is kept, rather than deleted, so that fencing tokens keep
+	// increasing for the next holder
+	if _, err := l.update(ctx, lease, time.Now()); err != nil {
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+
+	return nil
+}
+
+// update sets the expiry of a lease, provided it is still held.
+func (l *Leases) update(ctx context.Context, lease repositories.Lease, expiresAt time.Time) (*repositories.Lease, error) {
+	updated, err := scanLease(l.db.QueryRowContext(ctx, `
+		UPDATE leases SET expires_at = $4
+		WHERE binding_id = $1 AND peer_id = $2 AND token = $3
+		RETURNING `+leaseColumns,
+		lease.BindingID, lease.PeerID, lease.Token, expiresAt,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrLeaseLost
+		}
+
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func NewLeases(db *sql.DB) (*Leases, error) {
+	if err := checkSchema(db); err != nil {
+		return nil, err
+	}
+
+	return &Leases{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	db := testingPostgres(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Repositories refuse to use a database that has not been migrated
+	_, err := NewBindings(db)
+	assert.Error(t, err)
+
+	err = Migrate(ctx, db)
+	require.NoError(t, err)
+
+	// Migrating an up to date database does nothing
+	err = Migrate(ctx, db)
+	require.NoError(t, err)
+
+	_, err = NewBindings(db)
+	assert.NoError(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	migrations, err := migrations()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.version, "migrations must be numbered consecutively from 1")
+		assert.NotEmpty(t, m.sql)
+	}
+}
@@ -0,0 +1,56 @@
+CREATE TABLE peers (
+    id           uuid PRIMARY KEY,
+    hostname     text NOT NULL,
+    weight       double precision NOT NULL,
+    labels       jsonb NOT NULL DEFAULT '{}',
+    joined_at    timestamptz NOT NULL,
+    last_seen_at timestamptz NOT NULL,
+    delete_after timestamptz NOT NULL
+);
+
+CREATE INDEX peers_delete_after_idx ON peers (delete_after);
+
+CREATE TABLE bindings (
+    id                      uuid PRIMARY KEY,
+    lambda_arn              text NOT NULL,
+    nats_stream             text NOT NULL,
+    nats_consumer           uuid NOT NULL,
+    nats_subject_pattern    text NOT NULL,
+    max_messages            integer NOT NULL,
+    max_latency             bigint NOT NULL,
+    delivery_policy         text NOT NULL,
+    max_deliveries          integer NOT NULL,
+    dead_letter_subject     text NOT NULL,
+    retry_initial_delay     bigint NOT NULL,
+    retry_multiplier        double precision NOT NULL,
+    retry_max_delay         bigint NOT NULL,
+    retry_jitter            double precision NOT NULL,
+    invocation_type         text NOT NULL,
+    max_concurrency         integer NOT NULL,
+    partition_subject_token integer NOT NULL,
+    partition_header        text NOT NULL,
+    paused                  boolean NOT NULL DEFAULT false,
+    required_labels         jsonb NOT NULL DEFAULT '{}',
+    created_at              timestamptz NOT NULL,
+    updated_at              timestamptz NOT NULL
+);
+
+CREATE INDEX bindings_created_at_idx ON bindings (created_at);
+
+-- Leases are kept once released, so that fencing tokens keep increasing
+CREATE TABLE leases (
+    binding_id uuid PRIMARY KEY,
+    peer_id    uuid NOT NULL,
+    token      bigint NOT NULL,
+    expires_at timestamptz NOT NULL
+);
+
+CREATE TABLE binding_stats (
+    binding_id      uuid PRIMARY KEY,
+    peer_id         uuid NOT NULL,
+    running_until   timestamptz NOT NULL,
+    last_success_at timestamptz,
+    last_error_at   timestamptz,
+    last_error      text NOT NULL DEFAULT '',
+    updated_at      timestamptz NOT NULL
+);
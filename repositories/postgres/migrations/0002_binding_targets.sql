@@ -0,0 +1,3 @@
+-- Bindings deliver to a typed target, existing bindings all invoke a Lambda
+ALTER TABLE bindings RENAME COLUMN lambda_arn TO target_uri;
+ALTER TABLE bindings ADD COLUMN target_type text NOT NULL DEFAULT 'lambda';
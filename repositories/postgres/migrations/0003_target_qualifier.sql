@@ -0,0 +1 @@
+ALTER TABLE bindings ADD COLUMN target_qualifier text NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE bindings ADD COLUMN payload_format text NOT NULL DEFAULT '';
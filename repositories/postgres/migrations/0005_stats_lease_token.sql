@@ -0,0 +1 @@
+ALTER TABLE binding_stats ADD COLUMN lease_token bigint NOT NULL DEFAULT 0;
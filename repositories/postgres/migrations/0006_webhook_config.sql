@@ -0,0 +1,6 @@
+-- Webhook targets are configured per binding, falling back to the server's
+-- defaults while unset
+ALTER TABLE bindings ADD COLUMN webhook_headers jsonb NOT NULL DEFAULT '{}';
+ALTER TABLE bindings ADD COLUMN webhook_signing_secret text NOT NULL DEFAULT '';
+ALTER TABLE bindings ADD COLUMN webhook_timeout bigint NOT NULL DEFAULT 0;
+ALTER TABLE bindings ADD COLUMN webhook_insecure boolean NOT NULL DEFAULT false;
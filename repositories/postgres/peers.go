@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.Peers = (*Peers)(nil)
+
+type Peers struct {
+	db      *sql.DB
+	peerTTL time.Duration
+}
+
+const peerColumns = `id, hostname, weight, labels, joined_at, last_seen_at, delete_after`
+
+func scanPeer(row interface{ Scan(...any) error }) (*repositories.Peer, error) {
+	var (
+		peer   repositories.Peer
+		labels labels
+	)
+
+	if err := row.Scan(&peer.ID, &peer.Hostname, &peer.Weight, &labels, &peer.JoinedAt, &peer.LastSeenAt, &peer.HeartbeatDueBy); err != nil {
+		return nil, err
+	}
+
+	peer.Labels = repositories.Labels(labels)
+	return &peer, nil
+}
+
+func (s *Peers) JoinPeers(ctx context.Context, join *repositories.JoinPeer) (*repositories.Peer, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	// Clean up after peers that expired without leaving
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM peers WHERE delete_after <= $1`, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to delete expired peers: %w", err)
+	}
+
+	row := s.db.QueryRowContext(ctx, `
+		INSERT INTO peers (`+peerColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $5, $6)
+		RETURNING `+peerColumns,
+		uuid.New(), hostname, join.Weight, labels(join.Labels), time.Now(), time.Now().Add(s.peerTTL),
+	)
+
+	return scanPeer(row)
+}
+
+func (s *Peers) SendHeartbeat(ctx context.Context, id uuid.UUID) (*repositories.Peer, error) {
+	row := s.db.QueryRowContext(ctx, `
+		UPDATE peers
+		SET last_seen_at = $2, delete_after = $3
+		WHERE id = $1
+		RETURNING `+peerColumns,
+		id, time.Now(), time.Now().Add(s.peerTTL),
+	)
+
+	return scanPeer(row)
+}
+
+func (s *Peers) LeavePeers(ctx context.Context, id uuid.UUID) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM peers WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("peer %s not found", id)
+	}
+
+	return nil
+}
+
+func (s *Peers) ListPeers(ctx context.Context) ([]repositories.Peer, error) {
+	return listPeers(ctx, s.db)
+}
+
+// listPeers returns the peers that are due to send a heartbeat.
+func listPeers(ctx context.Context, db *sql.DB) ([]repositories.Peer, error) {
+	rows, err := db.QueryContext(ctx, `SELECT `+peerColumns+` FROM peers WHERE delete_after > $1`, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list peers: %w", err)
+	}
+	defer rows.Close()
+
+	var peers []repositories.Peer
+	for rows.Next() {
+		peer, err := scanPeer(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		peers = append(peers, *peer)
+	}
+
+	return peers, rows.Err()
+}
+
+func NewPeers(db *sql.DB) (*Peers, error) {
+	if err := checkSchema(db); err != nil {
+		return nil, err
+	}
+
+	return &Peers{
+		db:      db,
+		peerTTL: 5 * time.Second,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/google/uuid"
+)
+
+var _ repositories.Stats = (*Stats)(nil)
+
+type Stats struct {
+	db *sql.DB
+}
+
+func (s *Stats) RecordBindingStats(ctx context.Context, stats *repositories.BindingStats) error {
+	// Zero result times are stored as NULL, preserving those already recorded
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO binding_stats (binding_id, peer_id, running_until, last_success_at, last_error_at, last_error, updated_at, lease_token)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (binding_id) DO UPDATE
+		SET peer_id = excluded.peer_id,
+			lease_token = excluded.lease_token,
+			running_until = excluded.running_until,
+			last_success_at = COALESCE(excluded.last_success_at, binding_stats.last_success_at),
+			last_error_at = COALESCE(excluded.last_error_at, binding_stats.last_error_at),
+			last_error = CASE WHEN excluded.last_error_at IS NULL THEN binding_stats.last_error ELSE excluded.last_error END,
+			updated_at = excluded.updated_at
+		WHERE binding_stats.lease_token <= excluded.lease_token`,
+		stats.BindingID,
+		stats.PeerID,
+		stats.RunningUntil,
+		nullTime(stats.LastSuccessAt),
+		nullTime(stats.LastErrorAt),
+		stats.LastError,
+		stats.RecordedAt,
+		stats.LeaseToken,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record binding stats: %w", err)
+	}
+
+	// No row is written if the stats were recorded under a newer lease
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to record binding stats: %w", err)
+	}
+
+	if rows == 0 {
+		return repositories.ErrLeaseLost
+	}
+
+	return nil
+}
+
+func (s *Stats) GetBindingStats(ctx context.Context, bindingID uuid.UUID) (*repositories.BindingStats, error) {
+	var (
+		stats                      repositories.BindingStats
+		lastSuccessAt, lastErrorAt sql.NullTime
+	)
+
+	if err := s.db.QueryRowContext(ctx, `
+		SELECT binding_id, peer_id, running_until, last_success_at, last_error_at, last_error, updated_at, lease_token
+		FROM binding_stats WHERE binding_id = $1`,
+		bindingID,
+	).Scan(&stats.BindingID, &stats.PeerID, &stats.RunningUntil, &lastSuccessAt, &lastErrorAt, &stats.LastError, &stats.RecordedAt, &stats.LeaseToken); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	stats.LastSuccessAt = lastSuccessAt.Time
+	stats.LastErrorAt = lastErrorAt.Time
+	return &stats, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func NewStats(db *sql.DB) (*Stats, error) {
+	if err := checkSchema(db); err != nil {
+		return nil, err
+	}
+
+	return &Stats{
+		db: db,
+	}, nil
+}
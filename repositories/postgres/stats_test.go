@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge/repositories/conformancetest"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStatsConformance(t *testing.T) {
+	db := testingPostgres(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := Migrate(ctx, db)
+	require.NoError(t, err)
+
+	stats, err := NewStats(db)
+	require.NoError(t, err)
+
+	suite.Run(t, conformancetest.NewStatsConformanceSuite(stats))
+}
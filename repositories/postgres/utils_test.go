@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/ory/dockertest/v3"
+)
+
+func testingPostgres(t *testing.T) *sql.DB {
+	t.Helper()
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		t.Fatalf("Could construct docker pool: %s", err)
+	}
+
+	if err := pool.Client.Ping(); err != nil {
+		t.Fatalf("Could not connect to Docker: %s", err)
+	}
+
+	postgresContainer, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "postgres",
+		Tag:        "15",
+		Env:        []string{"POSTGRES_PASSWORD=test", "POSTGRES_DB=jetbridge"},
+	})
+	if err != nil {
+		t.Fatalf("Could not start postgres container: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := pool.Purge(postgresContainer); err != nil {
+			t.Fatalf("Could not purge postgres container: %s", err)
+		}
+	})
+
+	dsn := fmt.Sprintf("postgres://postgres:test@%s/jetbridge?sslmode=disable", postgresContainer.GetHostPort("5432/tcp"))
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("Could not open postgres: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := pool.Retry(db.Ping); err != nil {
+		t.Fatalf("Could not connect to postgres container: %s", err)
+	}
+
+	return db
+}
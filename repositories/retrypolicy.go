@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy controls how long a failed message waits before it is
+// redelivered. The delay grows exponentially with the number of times the
+// message has been delivered:
+//
+//	delay = InitialDelay * Multiplier^(deliveries-1)
+//
+// capped at MaxDelay, if set. Jitter, a fraction between 0 and 1, randomly
+// shortens each delay by up to that proportion to spread out retries.
+//
+// The zero value redelivers failed messages immediately.
+type RetryPolicy struct {
+	InitialDelay time.Duration
+	Multiplier   float64
+	MaxDelay     time.Duration
+	Jitter       float64
+}
+
+// Delay returns how long to wait before redelivering a message that has
+// failed after being delivered numDelivered times.
+func (p RetryPolicy) Delay(numDelivered uint64) time.Duration {
+	if p.InitialDelay <= 0 {
+		return 0
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	attempt := float64(0)
+	if numDelivered > 1 {
+		attempt = float64(numDelivered - 1)
+	}
+
+	delay := float64(p.InitialDelay) * math.Pow(multiplier, attempt)
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	// Guard against overflow for large delivery counts without a max delay
+	d := time.Duration(math.MaxInt64)
+	if delay < float64(math.MaxInt64) {
+		d = time.Duration(delay)
+	}
+
+	if p.Jitter > 0 {
+		d -= time.Duration(float64(d) * math.Min(p.Jitter, 1) * rand.Float64())
+	}
+
+	return d
+}
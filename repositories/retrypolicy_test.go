@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       RetryPolicy
+		numDelivered uint64
+		expected     time.Duration
+	}{
+		{name: "zero value", policy: RetryPolicy{}, numDelivered: 5, expected: 0},
+		{name: "first delivery", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 2}, numDelivered: 1, expected: time.Second},
+		{name: "third delivery", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 2}, numDelivered: 3, expected: 4 * time.Second},
+		{name: "constant", policy: RetryPolicy{InitialDelay: time.Second}, numDelivered: 10, expected: time.Second},
+		{name: "capped", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 2, MaxDelay: 5 * time.Second}, numDelivered: 10, expected: 5 * time.Second},
+		{name: "overflow", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 10}, numDelivered: 1000, expected: time.Duration(1<<63 - 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.Delay(tt.numDelivered))
+		})
+	}
+}
+
+func TestRetryPolicy_Delay_jitter(t *testing.T) {
+	policy := RetryPolicy{InitialDelay: 10 * time.Second, Multiplier: 2, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(2)
+		assert.GreaterOrEqual(t, delay, 10*time.Second)
+		assert.LessOrEqual(t, delay, 20*time.Second)
+	}
+}
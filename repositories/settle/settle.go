@@ -0,0 +1,358 @@
+// Package settle dispatches the messages of a binding to the binding's target
+// for a MessageHandler, then ACKs, NAKs, terminates and dead-letters them, so
+// that every target type batches messages and applies a binding's retry and
+// dead-letter policies in the same way.
+package settle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// ErrMessageFailed is the cause recorded for messages the target reported as
+// failed in its jetbridge.JetstreamBatchedLambdaResponse.
+var ErrMessageFailed = errors.New("message reported as failed by target")
+
+// ErrMessageRejected is the cause recorded for messages the target asked to
+// be terminated in its jetbridge.JetstreamBatchedLambdaResponse.
+var ErrMessageRejected = errors.New("message rejected by target")
+
+// Send delivers a payload, encoded in the binding's payload format with the
+// given content type, to the binding's target. It returns the target's
+// response, which for a batch may be a jetbridge.JetstreamBatchedLambdaResponse
+// reporting individual failures, or nil if every message succeeded.
+//
+// Errors that implement Retryable, such as those returned by Reject, decide
+// whether the messages are retried. Any other error is retried.
+type Send func(ctx context.Context, payload []byte, contentType string) ([]byte, error)
+
+// Retryable is implemented by errors that know whether retrying the messages
+// they failed may succeed.
+type Retryable interface {
+	error
+	Retryable() bool
+}
+
+// Reject marks err as the target rejecting the messages, which retrying would
+// never fix, so that they are terminated rather than NAK'd.
+func Reject(err error) error {
+	return &rejectedError{err: err}
+}
+
+type rejectedError struct {
+	err error
+}
+
+func (e *rejectedError) Error() string   { return e.err.Error() }
+func (e *rejectedError) Unwrap() error   { return e.err }
+func (e *rejectedError) Retryable() bool { return false }
+
+// Dispatch encodes the messages in the binding's payload format, sends them
+// to the binding's target and settles them according to the outcome.
+//
+// Batched bindings send the messages as one batch, settled as by
+// BatchResponse. Unbatched bindings send each message in turn, ACK-ing it once
+// it is sent. Once one fails, the rest are NAK'd without being sent, so that
+// they are not delivered ahead of the failed message.
+func Dispatch(ctx context.Context, logger *zap.Logger, binding repositories.JetstreamBinding, messages []repositories.JetstreamMessage, send Send) error {
+	if binding.Batched() {
+		var batch jetbridge.JetstreamBatchedLambdaPayload
+		for _, message := range messages {
+			batch = append(batch, message.Payload())
+		}
+
+		payload, contentType, err := binding.PayloadFormat.EncodeBatch(batch)
+		if err != nil {
+			err = fmt.Errorf("failed to marshal message: %w", err)
+			for _, message := range messages {
+				Nak(logger, binding, message, err)
+			}
+
+			return err
+		}
+
+		out, err := send(ctx, payload, contentType)
+		if err != nil {
+			for _, message := range messages {
+				fail(logger, binding, message, err)
+			}
+
+			return err
+		}
+
+		return BatchResponse(logger, binding, messages, out)
+	}
+
+	var rtnErr error
+	for _, message := range messages {
+		if rtnErr != nil {
+			Nak(logger, binding, message, rtnErr)
+			continue
+		}
+
+		payload, contentType, err := binding.PayloadFormat.Encode(message.Payload())
+		if err != nil {
+			rtnErr = fmt.Errorf("failed to marshal message: %w", err)
+			Nak(logger, binding, message, rtnErr)
+
+			continue
+		}
+
+		if _, err := send(ctx, payload, contentType); err != nil {
+			rtnErr = err
+			fail(logger, binding, message, rtnErr)
+
+			continue
+		}
+
+		if err := message.Ack(); err != nil {
+			logger.Error("failed to ACK message", zap.Error(err))
+			rtnErr = err
+
+			continue
+		}
+	}
+
+	return rtnErr
+}
+
+// fail settles a message the target did not accept, terminating it if the
+// target rejected it and otherwise NAK-ing it to be retried.
+func fail(logger *zap.Logger, binding repositories.JetstreamBinding, message repositories.JetstreamMessage, cause error) error {
+	var retryable Retryable
+	if !errors.As(cause, &retryable) || retryable.Retryable() {
+		return Nak(logger, binding, message, cause)
+	}
+
+	logger.Warn(
+		"target rejected message, terminating",
+		zap.Uint64("stream_sequence", message.Payload().Metadata.Sequence.Stream),
+		zap.Error(cause),
+	)
+
+	return Terminate(logger, binding, message, cause)
+}
+
+// Ack ACKs each of the messages, returning the last error encountered.
+func Ack(logger *zap.Logger, messages []repositories.JetstreamMessage) error {
+	var rtnErr error
+	for _, message := range messages {
+		if err := message.Ack(); err != nil {
+			logger.Error("failed to ACK message", zap.Error(err))
+			rtnErr = err
+		}
+	}
+
+	return rtnErr
+}
+
+// Nak returns a failed message for redelivery, after the delay given by the
+// binding's retry policy. Once the message has been delivered
+// binding.MaxDeliveries times it is instead terminated, see Terminate.
+func Nak(logger *zap.Logger, binding repositories.JetstreamBinding, message repositories.JetstreamMessage, cause error) error {
+	if retried(binding, message) {
+		return Release(logger, binding, message)
+	}
+
+	md := message.Payload().Metadata
+
+	logger.Warn(
+		"message exceeded max deliveries, terminating",
+		zap.Uint64("stream_sequence", md.Sequence.Stream),
+		zap.Uint64("num_delivered", md.NumDelivered),
+		zap.Error(cause),
+	)
+
+	return Terminate(logger, binding, message, cause)
+}
+
+// retried reports whether Nak returns the message for redelivery, rather than
+// terminating it for having reached the binding's max deliveries.
+func retried(binding repositories.JetstreamBinding, message repositories.JetstreamMessage) bool {
+	return binding.MaxDeliveries <= 0 || message.Payload().Metadata.NumDelivered < uint64(binding.MaxDeliveries)
+}
+
+// Terminate gives up on a message, publishing it to the binding's dead-letter
+// subject, if it has one, before terminating it so it is never redelivered.
+// Should dead-lettering fail, the message is NAK'd rather than dropped.
+func Terminate(logger *zap.Logger, binding repositories.JetstreamBinding, message repositories.JetstreamMessage, cause error) error {
+	if binding.DeadLetterSubject != "" {
+		md := message.Payload().Metadata
+
+		header := nats.Header{}
+		header.Set(jetbridge.DeadLetterBindingIDHeader, binding.ID.String())
+		header.Set(jetbridge.DeadLetterErrorHeader, cause.Error())
+		header.Set(jetbridge.DeadLetterNumDeliveredHeader, strconv.FormatUint(md.NumDelivered, 10))
+		header.Set(jetbridge.DeadLetterStreamHeader, md.Stream)
+		header.Set(jetbridge.DeadLetterStreamSequenceHeader, strconv.FormatUint(md.Sequence.Stream, 10))
+		header.Set(jetbridge.DeadLetterSubjectHeader, message.Payload().Subject)
+
+		if err := message.DeadLetter(binding.DeadLetterSubject, header); err != nil {
+			logger.Error("failed to dead-letter message", zap.String("subject", binding.DeadLetterSubject), zap.Error(err))
+
+			// Leave the message to be redelivered rather than dropping it
+			if err := message.Nak(); err != nil {
+				logger.Error("failed to NAK message", zap.Error(err))
+			}
+
+			return err
+		}
+	}
+
+	if err := message.Term(); err != nil {
+		logger.Error("failed to TERM message", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Release returns a message to the stream for redelivery, after the delay
+// given by the binding's retry policy.
+func Release(logger *zap.Logger, binding repositories.JetstreamBinding, message repositories.JetstreamMessage) error {
+	var err error
+	if delay := binding.RetryPolicy.Delay(message.Payload().Metadata.NumDelivered); delay > 0 {
+		err = message.NakWithDelay(delay)
+	} else {
+		err = message.Nak()
+	}
+
+	if err != nil {
+		logger.Error("failed to NAK message", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// holdBack NAKs a message that follows a message with the same partition key
+// that is being retried. It is redelivered after the same delay as the earlier
+// message, and is never terminated as it may not have failed itself.
+func holdBack(logger *zap.Logger, binding repositories.JetstreamBinding, message, earlier repositories.JetstreamMessage) error {
+	logger.Debug(
+		"holding back message behind failed message",
+		zap.Uint64("stream_sequence", message.Payload().Metadata.Sequence.Stream),
+		zap.Uint64("failed_stream_sequence", earlier.Payload().Metadata.Sequence.Stream),
+	)
+
+	var err error
+	if delay := binding.RetryPolicy.Delay(earlier.Payload().Metadata.NumDelivered); delay > 0 {
+		err = message.NakWithDelay(delay)
+	} else {
+		err = message.Nak()
+	}
+
+	if err != nil {
+		logger.Error("failed to NAK message", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// BatchResponse settles each message of a batch successfully delivered to the
+// target, according to the jetbridge.JetstreamBatchedLambdaResponse it
+// returned, ACK-ing the successes and NAK-ing or terminating the failures.
+// Terminated messages are dead-lettered, as by Terminate.
+//
+// For partitioned bindings, once a message is NAK'd to be retried the later
+// messages with the same key are NAK'd too, see holdBack, so that they are
+// not settled ahead of it.
+func BatchResponse(logger *zap.Logger, binding repositories.JetstreamBinding, messages []repositories.JetstreamMessage, out []byte) error {
+	failures, err := parseBatchResponse(messages, out)
+	if err != nil {
+		err = fmt.Errorf("invalid batch response: %w", err)
+		for _, message := range messages {
+			Nak(logger, binding, message, err)
+		}
+
+		return err
+	}
+
+	var (
+		rtnErr error
+		// retrying is the first message of each partition key being retried
+		retrying = make(map[string]repositories.JetstreamMessage)
+	)
+	for _, message := range messages {
+		key := binding.PartitionKey.Key(message.Payload())
+		if earlier, ok := retrying[key]; ok {
+			if err := holdBack(logger, binding, message, earlier); err != nil {
+				rtnErr = err
+			}
+
+			continue
+		}
+
+		failure, failed := failures[message.Payload().Metadata.Sequence.Stream]
+
+		switch {
+		case !failed:
+			if err := message.Ack(); err != nil {
+				logger.Error("failed to ACK message", zap.Error(err))
+				rtnErr = err
+			}
+
+		case failure.Terminate:
+			if err := Terminate(logger, binding, message, ErrMessageRejected); err != nil {
+				rtnErr = err
+			}
+
+		default:
+			if !binding.PartitionKey.IsZero() && retried(binding, message) {
+				retrying[key] = message
+			}
+
+			if err := Nak(logger, binding, message, ErrMessageFailed); err != nil {
+				rtnErr = err
+			}
+		}
+	}
+
+	if rtnErr != nil {
+		return rtnErr
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("target reported %d of %d messages as failed", len(failures), len(messages))
+	}
+
+	return nil
+}
+
+// parseBatchResponse decodes the target's response for a batch into the set
+// of failed messages, keyed by stream sequence number.
+func parseBatchResponse(messages []repositories.JetstreamMessage, out []byte) (map[uint64]jetbridge.JetstreamBatchItemFailure, error) {
+	failures := make(map[uint64]jetbridge.JetstreamBatchItemFailure)
+	if len(out) == 0 {
+		return failures, nil
+	}
+
+	var resp jetbridge.JetstreamBatchedLambdaResponse
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return nil, err
+	}
+
+	sequences := make(map[uint64]bool, len(messages))
+	for _, message := range messages {
+		sequences[message.Payload().Metadata.Sequence.Stream] = true
+	}
+
+	for _, failure := range resp.BatchItemFailures {
+		if !sequences[failure.Sequence] {
+			return nil, fmt.Errorf("sequence %d is not part of the batch", failure.Sequence)
+		}
+
+		failures[failure.Sequence] = failure
+	}
+
+	return failures, nil
+}
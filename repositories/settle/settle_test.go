@@ -0,0 +1,194 @@
+package settle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBatchResponse_terminateDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:                id,
+		Stream:            "test-stream",
+		Consumer:          id,
+		Subject:           "test-stream.*",
+		MaxMessages:       3,
+		MaxLatency:        time.Second,
+		MaxDeliveries:     5,
+		DeadLetterSubject: "test-dlq",
+	}
+
+	first, second, third := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2), mocks.NewTestingJetstreamMessage(ctrl, 3)
+	first.EXPECT().Ack().Return(nil)
+	second.EXPECT().Nak().Return(nil)
+
+	gomock.InOrder(
+		third.EXPECT().DeadLetter("test-dlq", gomock.Any()).DoAndReturn(func(_ string, header nats.Header) error {
+			assert.Equal(t, id.String(), header.Get(jetbridge.DeadLetterBindingIDHeader))
+			assert.Equal(t, ErrMessageRejected.Error(), header.Get(jetbridge.DeadLetterErrorHeader))
+			assert.Equal(t, "3", header.Get(jetbridge.DeadLetterStreamSequenceHeader))
+			return nil
+		}),
+		third.EXPECT().Term().Return(nil),
+	)
+
+	out := []byte(`{"batchItemFailures":[{"sequence":2},{"sequence":3,"terminate":true}]}`)
+	err := BatchResponse(zap.NewNop(), binding, []repositories.JetstreamMessage{first, second, third}, out)
+	assert.ErrorContains(t, err, "2 of 3 messages")
+}
+
+func TestBatchResponse_partitioned(t *testing.T) {
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:            id,
+		Stream:        "test-stream",
+		Consumer:      id,
+		Subject:       "test-stream.*",
+		MaxMessages:   4,
+		MaxLatency:    time.Second,
+		MaxDeliveries: 5,
+		RetryPolicy:   repositories.RetryPolicy{InitialDelay: time.Second, Multiplier: 2},
+		PartitionKey:  repositories.PartitionKey{SubjectToken: 2},
+	}
+
+	message := func(ctrl *gomock.Controller, sequence uint64, subject string, numDelivered uint64) *mocks.MockJetstreamMessage {
+		msg := mocks.NewMockJetstreamMessage(ctrl)
+		msg.EXPECT().Payload().Return(jetbridge.JetstreamLambdaPayload{
+			Subject: subject,
+			Metadata: nats.MsgMetadata{
+				Stream:       "test-stream",
+				Sequence:     nats.SequencePair{Stream: sequence},
+				NumDelivered: numDelivered,
+			},
+		}).AnyTimes()
+
+		return msg
+	}
+
+	t.Run("held back", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Later messages of the failed key are retried after it, even those
+		// the target reported as succeeding, while other keys are unaffected
+		a1, b2, a3, a4 := message(ctrl, 1, "test-stream.a", 3), message(ctrl, 2, "test-stream.b", 1), message(ctrl, 3, "test-stream.a", 1), message(ctrl, 4, "test-stream.a", 1)
+		a1.EXPECT().NakWithDelay(4 * time.Second).Return(nil)
+		b2.EXPECT().Ack().Return(nil)
+		a3.EXPECT().NakWithDelay(4 * time.Second).Return(nil)
+		a4.EXPECT().NakWithDelay(4 * time.Second).Return(nil)
+
+		out := []byte(`{"batchItemFailures":[{"sequence":1},{"sequence":4}]}`)
+		err := BatchResponse(zap.NewNop(), binding, []repositories.JetstreamMessage{a1, b2, a3, a4}, out)
+		assert.ErrorContains(t, err, "2 of 4 messages")
+	})
+
+	t.Run("terminated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// A terminated message is never retried, so does not hold back the
+		// messages after it
+		a1, a2 := message(ctrl, 1, "test-stream.a", 5), message(ctrl, 2, "test-stream.a", 1)
+		a1.EXPECT().Term().Return(nil)
+		a2.EXPECT().Ack().Return(nil)
+
+		out := []byte(`{"batchItemFailures":[{"sequence":1}]}`)
+		err := BatchResponse(zap.NewNop(), binding, []repositories.JetstreamMessage{a1, a2}, out)
+		assert.ErrorContains(t, err, "1 of 2 messages")
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	id := uuid.New()
+	binding := repositories.JetstreamBinding{
+		ID:       id,
+		Stream:   "test-stream",
+		Consumer: id,
+		Subject:  "test-stream.*",
+	}
+
+	t.Run("unbatched", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		first, second, third := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2), mocks.NewTestingJetstreamMessage(ctrl, 3)
+		first.EXPECT().Ack().Return(nil)
+		second.EXPECT().Nak().Return(nil)
+		third.EXPECT().Nak().Return(nil)
+
+		var sent int
+		err := Dispatch(context.TODO(), zap.NewNop(), binding, []repositories.JetstreamMessage{first, second, third}, func(context.Context, []byte, string) ([]byte, error) {
+			sent++
+			if sent == 2 {
+				return nil, errors.New("unavailable")
+			}
+			return nil, nil
+		})
+		assert.EqualError(t, err, "unavailable")
+		assert.Equal(t, 2, sent, "messages after a failure should not be sent")
+	})
+
+	t.Run("unbatched rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		message := mocks.NewTestingJetstreamMessage(ctrl, 1)
+		message.EXPECT().Term().Return(nil)
+
+		err := Dispatch(context.TODO(), zap.NewNop(), binding, []repositories.JetstreamMessage{message}, func(context.Context, []byte, string) ([]byte, error) {
+			return nil, Reject(errors.New("invalid"))
+		})
+		assert.EqualError(t, err, "invalid")
+	})
+
+	t.Run("batched", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binding := binding
+		binding.MaxMessages = 2
+		binding.MaxLatency = time.Second
+
+		first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+		first.EXPECT().Ack().Return(nil)
+		second.EXPECT().Nak().Return(nil)
+
+		err := Dispatch(context.TODO(), zap.NewNop(), binding, []repositories.JetstreamMessage{first, second}, func(_ context.Context, payload []byte, contentType string) ([]byte, error) {
+			assert.Equal(t, "application/json", contentType)
+			return []byte(`{"batchItemFailures":[{"sequence":2}]}`), nil
+		})
+		assert.ErrorContains(t, err, "1 of 2 messages")
+	})
+
+	t.Run("batched rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binding := binding
+		binding.MaxMessages = 2
+		binding.MaxLatency = time.Second
+
+		first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+		first.EXPECT().Term().Return(nil)
+		second.EXPECT().Term().Return(nil)
+
+		err := Dispatch(context.TODO(), zap.NewNop(), binding, []repositories.JetstreamMessage{first, second}, func(context.Context, []byte, string) ([]byte, error) {
+			return nil, Reject(errors.New("invalid"))
+		})
+		assert.EqualError(t, err, "invalid")
+	})
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_stats.go -package=mocks . Stats
+
+// Stats stores the runtime statistics that peers record for the bindings they
+// run, so that any peer can report on a binding.
+type Stats interface {
+	// RecordBindingStats records the stats of a binding's worker. Zero result
+	// times are ignored, preserving the results recorded by previous workers.
+	// Stats recorded under an older lease than those stored are rejected with
+	// ErrLeaseLost.
+	RecordBindingStats(ctx context.Context, stats *BindingStats) error
+
+	// GetBindingStats returns the stats last recorded for a binding, or nil if
+	// no worker has recorded any.
+	GetBindingStats(ctx context.Context, bindingID uuid.UUID) (*BindingStats, error)
+}
+
+type BindingStats struct {
+	BindingID uuid.UUID
+
+	// PeerID is the peer whose worker last recorded the stats, at RecordedAt.
+	PeerID     uuid.UUID
+	RecordedAt time.Time
+
+	// LeaseToken is the fencing token of the lease the worker holds, so that
+	// a worker that has lost its lease cannot overwrite the stats of the peer
+	// that acquired it.
+	LeaseToken int64
+
+	// RunningUntil is refreshed as the worker runs, once it has passed the
+	// worker is no longer considered to be running.
+	RunningUntil time.Time
+
+	LastSuccessAt time.Time
+	LastErrorAt   time.Time
+	LastError     string
+}
+
+// Running returns whether the worker of the given peer is running the binding.
+func (s BindingStats) Running(peerID *uuid.UUID, now time.Time) bool {
+	return peerID != nil && s.PeerID == *peerID && now.Before(s.RunningUntil)
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindingStats_Running(t *testing.T) {
+	t.Parallel()
+
+	var (
+		peerID      = uuid.New()
+		otherPeerID = uuid.New()
+		now         = time.Now()
+	)
+
+	stats := BindingStats{
+		PeerID:       peerID,
+		RunningUntil: now.Add(time.Second),
+	}
+
+	assert.True(t, stats.Running(&peerID, now))
+	assert.False(t, stats.Running(&otherPeerID, now), "another peer's worker is not running the binding")
+	assert.False(t, stats.Running(nil, now), "unassigned bindings are not running")
+	assert.False(t, stats.Running(&peerID, now.Add(2*time.Second)), "the worker has stopped refreshing its stats")
+}
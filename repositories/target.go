@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// TargetType selects the kind of service a binding delivers its messages to,
+// and so which MessageHandler handles them.
+//
+// The zero value is equivalent to TargetLambda.
+type TargetType string
+
+const (
+	// TargetLambda invokes the Lambda function named by the target URI, which
+	// is the function's name, or its full or partial ARN.
+	TargetLambda TargetType = "lambda"
+	// TargetWebhook POSTs messages to the HTTPS URL given by the target URI,
+	// configured by the target's Webhook config.
+	TargetWebhook TargetType = "webhook"
+	// TargetNATS sends messages as NATS requests to the subject given by the
+	// target URI, such as that of a NATS service.
+	TargetNATS TargetType = "nats"
+)
+
+// Target identifies where a binding delivers its messages.
+type Target struct {
+	Type TargetType
+	URI  string
+	// Qualifier is the version or alias of a Lambda target to invoke. The
+	// unqualified function, $LATEST, is invoked if it is empty.
+	Qualifier string
+	// Webhook configures the requests made to a webhook target.
+	Webhook WebhookConfig
+}
+
+// WebhookConfig configures the requests made to a binding's webhook target.
+// Unset fields fall back to the defaults the server is run with.
+type WebhookConfig struct {
+	// Headers are added to every request, for example to authenticate with
+	// the webhook, replacing any default header of the same name.
+	Headers map[string]string
+	// SigningSecret, if set, signs every request. See jetbridge.SignWebhook.
+	SigningSecret string
+	// Timeout bounds each request, including reading its response.
+	Timeout time.Duration
+	// Insecure allows the URL to use plain http, sending the messages and any
+	// headers unencrypted.
+	Insecure bool
+}
+
+// IsZero reports whether the config is unset.
+func (c WebhookConfig) IsZero() bool {
+	return len(c.Headers) == 0 && c.SigningSecret == "" && c.Timeout == 0 && !c.Insecure
+}
+
+// Validate checks that the URI is valid for the target type.
+func (t Target) Validate() error {
+	if t.URI == "" {
+		return errors.New("target URI must not be empty")
+	}
+
+	if t.Qualifier != "" && t.Type != "" && t.Type != TargetLambda {
+		return errors.New("only lambda targets may have a qualifier")
+	}
+
+	if !t.Webhook.IsZero() && t.Type != TargetWebhook {
+		return errors.New("only webhook targets may have a webhook config")
+	}
+
+	switch t.Type {
+	case "", TargetLambda:
+		return validateLambdaFunction(t.URI, t.Qualifier)
+
+	case TargetWebhook:
+		u, err := url.Parse(t.URI)
+		if err != nil {
+			return fmt.Errorf("invalid webhook URL: %w", err)
+		}
+
+		switch {
+		case u.Scheme == "http" && !t.Webhook.Insecure:
+			return errors.New("webhook URL must be https, unless the webhook is marked insecure")
+		case u.Scheme != "https" && u.Scheme != "http":
+			return fmt.Errorf("webhook URL must be https, got %q", u.Scheme)
+		}
+
+		if u.Host == "" {
+			return errors.New("webhook URL must have a host")
+		}
+
+		return t.Webhook.validate()
+
+	case TargetNATS:
+		if strings.ContainsAny(t.URI, " \t\r\n*>") {
+			return fmt.Errorf("invalid NATS subject %q, it must not contain whitespace or wildcards", t.URI)
+		}
+
+		for _, token := range strings.Split(t.URI, ".") {
+			if token == "" {
+				return fmt.Errorf("invalid NATS subject %q, it must not contain empty tokens", t.URI)
+			}
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown target type %q", t.Type)
+	}
+}
+
+// httpHeaderName matches the token characters allowed in a header name.
+var httpHeaderName = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+func (c WebhookConfig) validate() error {
+	for name, value := range c.Headers {
+		if !httpHeaderName.MatchString(name) {
+			return fmt.Errorf("invalid webhook header name %q", name)
+		}
+
+		if strings.ContainsAny(value, "\r\n\x00") {
+			return fmt.Errorf("invalid value for webhook header %q", name)
+		}
+	}
+
+	if c.Timeout < 0 {
+		return errors.New("webhook timeout must not be negative")
+	}
+
+	return nil
+}
+
+var (
+	lambdaFunctionName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+	lambdaQualifier    = regexp.MustCompile(`^(\$LATEST|[a-zA-Z0-9_-]{1,128})$`)
+	awsAccountID       = regexp.MustCompile(`^[0-9]{12}$`)
+)
+
+// validateLambdaFunction checks a function is named in one of the forms
+// accepted by the Lambda API: a name, such as my-function, a partial ARN, such
+// as 123456789012:function:my-function, or a full ARN. Each may end with a
+// version or alias, which must not also be given as the qualifier.
+func validateLambdaFunction(function, qualifier string) error {
+	var parts []string
+	if arn.IsARN(function) {
+		a, err := arn.Parse(function)
+		if err != nil {
+			return fmt.Errorf("invalid lambda function ARN: %w", err)
+		}
+
+		if a.Service != "lambda" {
+			return fmt.Errorf("invalid lambda function ARN %q, it is for the %q service", function, a.Service)
+		}
+
+		if a.Region == "" {
+			return fmt.Errorf("invalid lambda function ARN %q, it has no region", function)
+		}
+
+		parts = append([]string{a.AccountID}, strings.Split(a.Resource, ":")...)
+	} else {
+		parts = strings.Split(function, ":")
+	}
+
+	var name, arnQualifier string
+	switch {
+	case len(parts) <= 2:
+		name = parts[0]
+		if len(parts) == 2 {
+			arnQualifier = parts[1]
+		}
+	case len(parts) <= 4 && parts[1] == "function":
+		if !awsAccountID.MatchString(parts[0]) {
+			return fmt.Errorf("invalid lambda function %q, %q is not an AWS account ID", function, parts[0])
+		}
+
+		name = parts[2]
+		if len(parts) == 4 {
+			arnQualifier = parts[3]
+		}
+	default:
+		return fmt.Errorf("invalid lambda function %q, expected a name or ARN", function)
+	}
+
+	if !lambdaFunctionName.MatchString(name) {
+		return fmt.Errorf("invalid lambda function name %q", name)
+	}
+
+	if arnQualifier != "" && qualifier != "" {
+		return fmt.Errorf("lambda function %q is already qualified", function)
+	}
+
+	if q := arnQualifier + qualifier; q != "" && !lambdaQualifier.MatchString(q) {
+		return fmt.Errorf("invalid lambda qualifier %q", q)
+	}
+
+	return nil
+}
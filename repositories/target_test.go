@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTarget_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  Target
+		wantErr bool
+	}{
+		{name: "lambda", target: Target{Type: TargetLambda, URI: "arn:aws:lambda:us-east-1:123456789012:function:my-function"}},
+		{name: "zero type", target: Target{URI: "my-function"}},
+		{name: "empty lambda", target: Target{Type: TargetLambda}, wantErr: true},
+		{name: "https webhook", target: Target{Type: TargetWebhook, URI: "https://example.com/hooks/orders"}},
+		{name: "http webhook", target: Target{Type: TargetWebhook, URI: "http://orders.internal:8080/hook"}, wantErr: true},
+		{name: "insecure http webhook", target: Target{Type: TargetWebhook, URI: "http://orders.internal:8080/hook", Webhook: WebhookConfig{Insecure: true}}},
+		{name: "webhook config", target: Target{Type: TargetWebhook, URI: "https://example.com/hooks/orders", Webhook: WebhookConfig{
+			Headers:       map[string]string{"Authorization": "Bearer token"},
+			SigningSecret: "secret",
+			Timeout:       time.Second,
+		}}},
+		{name: "webhook header name", target: Target{Type: TargetWebhook, URI: "https://example.com", Webhook: WebhookConfig{Headers: map[string]string{"Bad Header": "x"}}}, wantErr: true},
+		{name: "webhook header value", target: Target{Type: TargetWebhook, URI: "https://example.com", Webhook: WebhookConfig{Headers: map[string]string{"X-Tenant": "a\r\nHost: evil"}}}, wantErr: true},
+		{name: "webhook negative timeout", target: Target{Type: TargetWebhook, URI: "https://example.com", Webhook: WebhookConfig{Timeout: -time.Second}}, wantErr: true},
+		{name: "lambda with webhook config", target: Target{Type: TargetLambda, URI: "my-function", Webhook: WebhookConfig{SigningSecret: "secret"}}, wantErr: true},
+		{name: "webhook scheme", target: Target{Type: TargetWebhook, URI: "ftp://example.com"}, wantErr: true},
+		{name: "webhook without host", target: Target{Type: TargetWebhook, URI: "https:///hook"}, wantErr: true},
+		{name: "relative webhook", target: Target{Type: TargetWebhook, URI: "/hook"}, wantErr: true},
+		{name: "qualified lambda", target: Target{Type: TargetLambda, URI: "my-function", Qualifier: "live"}},
+		{name: "lambda ARN with alias", target: Target{Type: TargetLambda, URI: "arn:aws:lambda:us-east-1:123456789012:function:my-function:live"}},
+		{name: "lambda partial ARN", target: Target{Type: TargetLambda, URI: "123456789012:function:my-function"}},
+		{name: "lambda name with version", target: Target{Type: TargetLambda, URI: "my-function:7"}},
+		{name: "lambda GovCloud ARN", target: Target{Type: TargetLambda, URI: "arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:my-function"}},
+		{name: "lambda latest", target: Target{Type: TargetLambda, URI: "my-function", Qualifier: "$LATEST"}},
+		{name: "lambda ARN for other service", target: Target{Type: TargetLambda, URI: "arn:aws:sqs:us-east-1:123456789012:my-queue"}, wantErr: true},
+		{name: "lambda ARN without region", target: Target{Type: TargetLambda, URI: "arn:aws:lambda::123456789012:function:my-function"}, wantErr: true},
+		{name: "lambda ARN for layer", target: Target{Type: TargetLambda, URI: "arn:aws:lambda:us-east-1:123456789012:layer:my-layer:1"}, wantErr: true},
+		{name: "lambda truncated ARN", target: Target{Type: TargetLambda, URI: "arn:aws:lambda:us-east-1:123456789012"}, wantErr: true},
+		{name: "lambda invalid account", target: Target{Type: TargetLambda, URI: "1234:function:my-function"}, wantErr: true},
+		{name: "lambda invalid name", target: Target{Type: TargetLambda, URI: "my function"}, wantErr: true},
+		{name: "lambda invalid qualifier", target: Target{Type: TargetLambda, URI: "my-function", Qualifier: "live!"}, wantErr: true},
+		{name: "lambda qualified twice", target: Target{Type: TargetLambda, URI: "my-function:live", Qualifier: "live"}, wantErr: true},
+		{name: "qualified webhook", target: Target{Type: TargetWebhook, URI: "https://example.com", Qualifier: "live"}, wantErr: true},
+		{name: "nats", target: Target{Type: TargetNATS, URI: "orders.process"}},
+		{name: "nats wildcard", target: Target{Type: TargetNATS, URI: "orders.*"}, wantErr: true},
+		{name: "nats empty token", target: Target{Type: TargetNATS, URI: "orders..process"}, wantErr: true},
+		{name: "nats whitespace", target: Target{Type: TargetNATS, URI: "orders process"}, wantErr: true},
+		{name: "unknown type", target: Target{Type: "sqs", URI: "queue"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.target.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
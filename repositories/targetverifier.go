@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+)
+
+//go:generate go run github.com/golang/mock/mockgen -destination=./mocks/mock_targetverifier.go -package=mocks . TargetVerifier
+
+// ErrTargetNotFound is returned when the service a target names does not
+// exist, such as a Lambda function that has not been deployed.
+var ErrTargetNotFound = errors.New("target not found")
+
+// TargetVerifier checks that the service a binding's target names exists
+// before the binding is saved, so that mistakes in the target are reported to
+// whoever made them rather than by the workers delivering its messages.
+//
+// Implementations need only verify the target types they know of, and should
+// return nil for any other type.
+type TargetVerifier interface {
+	VerifyTarget(ctx context.Context, target Target) error
+}
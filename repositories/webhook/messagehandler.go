@@ -0,0 +1,256 @@
+// Package webhook delivers the messages of bindings with webhook targets by
+// POSTing them to the target URL.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/metrics"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/settle"
+	"github.com/JoeReid/jetbridge/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// DefaultTimeout bounds each request if neither the binding's webhook config
+// nor Config sets a timeout.
+const DefaultTimeout = 30 * time.Second
+
+// maxResponseSize limits how much of a response body is read, which need only
+// be large enough for a jetbridge.JetstreamBatchedLambdaResponse.
+const maxResponseSize = 1 << 20
+
+var _ repositories.MessageHandler = (*MessageHandler)(nil)
+
+// Config configures the requests made by a MessageHandler. Headers,
+// SigningSecret and Timeout are defaults, used for bindings whose
+// repositories.WebhookConfig leaves them unset.
+type Config struct {
+	// Headers are added to every request, for example to authenticate with
+	// the target. They cannot replace the headers set by JetBridge itself.
+	Headers http.Header
+	// SigningSecret, if set, signs every request. See jetbridge.SignWebhook.
+	SigningSecret []byte
+	// Timeout bounds each request, including reading its response.
+	Timeout time.Duration
+	// Transport makes the requests, http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// MessageHandler POSTs the messages of a binding to its webhook URL, encoded in
+// the binding's payload format.
+//
+// Messages are ACK'd if the webhook responds with a 2xx status. A batch may
+// report individual failures with a jetbridge.JetstreamBatchedLambdaResponse
+// body, as a Lambda would, with a JSON Content-Type. Bodies of any other type
+// are ignored. Timeouts, connection errors, 5xx responses and the
+// 401, 407, 408, 409 and 429 statuses are retried according to the binding's
+// retry policy. Any other status, including redirects, rejects the messages,
+// which are dead-lettered, if the binding has a dead-letter subject, and
+// terminated without being retried.
+type MessageHandler struct {
+	logger        *zap.Logger
+	client        *http.Client
+	headers       http.Header
+	signingSecret []byte
+	timeout       time.Duration
+	now           func() time.Time
+}
+
+func (m *MessageHandler) HandleJetstreamMessages(ctx context.Context, binding repositories.JetstreamBinding, messages []repositories.JetstreamMessage) error {
+	return settle.Dispatch(ctx, m.bindingLogger(binding), binding, messages, func(ctx context.Context, payload []byte, contentType string) ([]byte, error) {
+		out, err := m.post(ctx, binding, payload, contentType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call webhook: %w", err)
+		}
+
+		return out, nil
+	})
+}
+
+func (m *MessageHandler) post(ctx context.Context, binding repositories.JetstreamBinding, payload []byte, contentType string) (_ []byte, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "webhook.post", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("jetbridge.binding_id", binding.ID.String()),
+		attribute.String("http.method", http.MethodPost),
+		attribute.String("http.url", binding.Target.URI),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	config := binding.Target.Webhook
+
+	timeout := m.timeout
+	if config.Timeout > 0 {
+		timeout = config.Timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, binding.Target.URI, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range m.headers {
+		req.Header[key] = values
+	}
+
+	for key, value := range config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	signingSecret := m.signingSecret
+	if config.SigningSecret != "" {
+		signingSecret = []byte(config.SigningSecret)
+	}
+
+	timestamp := m.now()
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(jetbridge.BindingIDHeader, binding.ID.String())
+	req.Header.Set(jetbridge.WebhookTimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+
+	if len(signingSecret) > 0 {
+		req.Header.Set(jetbridge.WebhookSignatureHeader, jetbridge.SignWebhook(signingSecret, timestamp, payload))
+	}
+
+	// Forward the trace context so the webhook can continue the trace
+	tracing.Inject(ctx, req.Header)
+
+	start := time.Now()
+	resp, err := m.client.Do(req)
+	if err != nil {
+		metrics.WebhookRequestErrors.WithLabelValues(binding.ID.String()).Inc()
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	out, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	metrics.WebhookRequestDuration.WithLabelValues(binding.ID.String()).Observe(time.Since(start).Seconds())
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		metrics.WebhookRequestErrors.WithLabelValues(binding.ID.String()).Inc()
+
+		m.bindingLogger(binding).Debug(
+			"webhook returned error",
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", out),
+		)
+		return nil, &StatusError{StatusCode: resp.StatusCode}
+	}
+
+	if err != nil {
+		metrics.WebhookRequestErrors.WithLabelValues(binding.ID.String()).Inc()
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	m.bindingLogger(binding).Debug("webhook called successfully", zap.Int("status", resp.StatusCode))
+
+	// Only a JSON body can report failures, any other body, such as "OK" or
+	// an HTML page, is taken to mean every message succeeded
+	if !isJSON(resp.Header.Get("Content-Type")) {
+		return nil, nil
+	}
+
+	return out, nil
+}
+
+// isJSON reports whether the media type of a Content-Type header is JSON, such
+// as application/json or application/problem+json.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+var _ settle.Retryable = (*StatusError)(nil)
+
+// StatusError is returned when a webhook responds with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("webhook returned %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Retryable reports whether the request may succeed if it is retried, rather
+// than the webhook having rejected the messages.
+func (e *StatusError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusProxyAuthRequired, http.StatusRequestTimeout, http.StatusConflict, http.StatusTooManyRequests:
+		return true
+	default:
+		return e.StatusCode >= 500
+	}
+}
+
+// ParseHeaders parses headers given in the form "Name: value", as accepted by
+// the command line.
+func ParseHeaders(raw []string) (http.Header, error) {
+	headers := http.Header{}
+	for _, r := range raw {
+		name, value, ok := strings.Cut(r, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid header %q, expected Name: value", r)
+		}
+
+		headers.Add(name, strings.TrimSpace(value))
+	}
+
+	return headers, nil
+}
+
+func NewMessageHandler(config Config, logger *zap.Logger) (*MessageHandler, error) {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &MessageHandler{
+		logger: logger.With(zap.String("component", "webhook")),
+		client: &http.Client{
+			Transport: config.Transport,
+			// Following a redirect would turn the POST into a GET
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		headers:       config.Headers.Clone(),
+		signingSecret: config.SigningSecret,
+		timeout:       timeout,
+		now:           time.Now,
+	}, nil
+}
+
+// bindingLogger returns a logger annotated with the fields identifying a binding
+// and the URL it calls.
+func (m *MessageHandler) bindingLogger(binding repositories.JetstreamBinding) *zap.Logger {
+	return m.logger.With(
+		zap.String("binding_id", binding.ID.String()),
+		zap.String("stream", binding.Stream),
+		zap.String("url", binding.Target.URI),
+	)
+}
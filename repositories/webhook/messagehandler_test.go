@@ -0,0 +1,363 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/JoeReid/jetbridge"
+	"github.com/JoeReid/jetbridge/repositories"
+	"github.com/JoeReid/jetbridge/repositories/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func testingBinding(url string) repositories.JetstreamBinding {
+	id := uuid.New()
+	return repositories.JetstreamBinding{
+		ID:       id,
+		Target:   repositories.Target{Type: repositories.TargetWebhook, URI: url},
+		Stream:   "test-stream",
+		Consumer: id,
+		Subject:  "test-stream.*",
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_request(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := []byte("test-secret")
+
+	var received []jetbridge.JetstreamLambdaPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.NoError(t, jetbridge.VerifyWebhook(secret, r.Header, body, time.Minute))
+
+		var payload jetbridge.JetstreamLambdaPayload
+		require.NoError(t, json.Unmarshal(body, &payload))
+		received = append(received, payload)
+	}))
+	defer server.Close()
+
+	binding := testingBinding(server.URL)
+
+	candidate, err := NewMessageHandler(Config{
+		Headers:       http.Header{"Authorization": []string{"Bearer test-token"}},
+		SigningSecret: secret,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+	first.EXPECT().Ack().Return(nil)
+	second.EXPECT().Ack().Return(nil)
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+	require.NoError(t, err)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, uint64(1), received[0].Metadata.Sequence.Stream)
+	assert.Equal(t, uint64(2), received[1].Metadata.Sequence.Stream)
+}
+
+func TestMessageHandler_HandleJetstreamMessages_bindingConfig(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := []byte("binding-secret")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Bearer binding-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "default", r.Header.Get("X-Default"))
+		assert.NoError(t, jetbridge.VerifyWebhook(secret, r.Header, body, time.Minute))
+	}))
+	defer server.Close()
+
+	// The binding's config replaces the defaults it sets
+	binding := testingBinding(server.URL)
+	binding.Target.Webhook = repositories.WebhookConfig{
+		Headers:       map[string]string{"Authorization": "Bearer binding-token"},
+		SigningSecret: string(secret),
+	}
+
+	candidate, err := NewMessageHandler(Config{
+		Headers:       http.Header{"Authorization": []string{"Bearer default-token"}, "X-Default": []string{"default"}},
+		SigningSecret: []byte("default-secret"),
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	message := mocks.NewTestingJetstreamMessage(ctrl, 1)
+	message.EXPECT().Ack().Return(nil)
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{message})
+	require.NoError(t, err)
+}
+
+func TestMessageHandler_HandleJetstreamMessages_status(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		expect func(first, second *mocks.MockJetstreamMessage)
+	}{
+		{
+			name:   "success",
+			status: http.StatusAccepted,
+			expect: func(first, second *mocks.MockJetstreamMessage) {
+				first.EXPECT().Ack().Return(nil)
+				second.EXPECT().Ack().Return(nil)
+			},
+		},
+		{
+			name:   "server error",
+			status: http.StatusServiceUnavailable,
+			expect: func(first, second *mocks.MockJetstreamMessage) {
+				first.EXPECT().Nak().Return(nil)
+				second.EXPECT().Nak().Return(nil)
+			},
+		},
+		{
+			name:   "too many requests",
+			status: http.StatusTooManyRequests,
+			expect: func(first, second *mocks.MockJetstreamMessage) {
+				first.EXPECT().Nak().Return(nil)
+				second.EXPECT().Nak().Return(nil)
+			},
+		},
+		{
+			name:   "rejected",
+			status: http.StatusBadRequest,
+			expect: func(first, second *mocks.MockJetstreamMessage) {
+				first.EXPECT().DeadLetter("test-dlq", gomock.Any()).DoAndReturn(func(_ string, header nats.Header) error {
+					assert.Contains(t, header.Get(jetbridge.DeadLetterErrorHeader), "400")
+					return nil
+				})
+				first.EXPECT().Term().Return(nil)
+
+				// Not yet sent, so returned to the stream
+				second.EXPECT().Nak().Return(nil)
+			},
+		},
+		{
+			name:   "redirect",
+			status: http.StatusFound,
+			expect: func(first, second *mocks.MockJetstreamMessage) {
+				first.EXPECT().DeadLetter("test-dlq", gomock.Any()).Return(nil)
+				first.EXPECT().Term().Return(nil)
+				second.EXPECT().Nak().Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.status == http.StatusFound {
+					http.Redirect(w, r, "/elsewhere", tt.status)
+					return
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			binding := testingBinding(server.URL)
+			binding.MaxDeliveries = 5
+			binding.DeadLetterSubject = "test-dlq"
+
+			candidate, err := NewMessageHandler(Config{}, zap.NewNop())
+			require.NoError(t, err)
+
+			first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+			tt.expect(first, second)
+
+			err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+			if tt.status >= 200 && tt.status <= 299 {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "webhook returned")
+			}
+		})
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_batchResponse(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch jetbridge.JetstreamBatchedLambdaPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		require.Len(t, batch, 3)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"batchItemFailures":[{"sequence":2},{"sequence":3,"terminate":true}]}`))
+	}))
+	defer server.Close()
+
+	binding := testingBinding(server.URL)
+	binding.MaxMessages = 3
+	binding.MaxLatency = time.Second
+
+	candidate, err := NewMessageHandler(Config{}, zap.NewNop())
+	require.NoError(t, err)
+
+	first, second, third := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2), mocks.NewTestingJetstreamMessage(ctrl, 3)
+	first.EXPECT().Ack().Return(nil)
+	second.EXPECT().Nak().Return(nil)
+	third.EXPECT().Term().Return(nil)
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second, third})
+	assert.ErrorContains(t, err, "2 of 3 messages")
+}
+
+func TestMessageHandler_HandleJetstreamMessages_nonJSONResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{name: "text", contentType: "text/plain; charset=utf-8", body: "OK"},
+		{name: "html", contentType: "text/html", body: "<html><body>Accepted</body></html>"},
+		{name: "empty json", contentType: "application/json", body: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			binding := testingBinding(server.URL)
+			binding.MaxMessages = 2
+			binding.MaxLatency = time.Second
+
+			candidate, err := NewMessageHandler(Config{}, zap.NewNop())
+			require.NoError(t, err)
+
+			first, second := mocks.NewTestingJetstreamMessage(ctrl, 1), mocks.NewTestingJetstreamMessage(ctrl, 2)
+			first.EXPECT().Ack().Return(nil)
+			second.EXPECT().Ack().Return(nil)
+
+			err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{first, second})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMessageHandler_HandleJetstreamMessages_timeout(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	candidate, err := NewMessageHandler(Config{Timeout: 10 * time.Millisecond}, zap.NewNop())
+	require.NoError(t, err)
+
+	message := mocks.NewTestingJetstreamMessage(ctrl, 1)
+	message.EXPECT().Nak().Return(nil)
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), testingBinding(server.URL), []repositories.JetstreamMessage{message})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A binding may set a shorter timeout than the default
+	candidate, err = NewMessageHandler(Config{}, zap.NewNop())
+	require.NoError(t, err)
+
+	binding := testingBinding(server.URL)
+	binding.Target.Webhook.Timeout = 10 * time.Millisecond
+
+	message = mocks.NewTestingJetstreamMessage(ctrl, 2)
+	message.EXPECT().Nak().Return(nil)
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{message})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestMessageHandler_HandleJetstreamMessages_payloadFormat(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var received jetbridge.CloudEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, jetbridge.ContentTypeCloudEvent, r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	binding := testingBinding(server.URL)
+	binding.PayloadFormat = repositories.PayloadFormatCloudEvents
+
+	candidate, err := NewMessageHandler(Config{}, zap.NewNop())
+	require.NoError(t, err)
+
+	message := mocks.NewTestingJetstreamMessage(ctrl, 7)
+	message.EXPECT().Ack().Return(nil)
+
+	err = candidate.HandleJetstreamMessages(context.TODO(), binding, []repositories.JetstreamMessage{message})
+	require.NoError(t, err)
+
+	assert.Equal(t, "7", received.ID)
+	assert.Equal(t, "/jetstream/test-stream", received.Source)
+	assert.Equal(t, []byte("test"), received.Bytes())
+}
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders([]string{"Authorization: Bearer token", "x-tenant:orders"})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", headers.Get("Authorization"))
+	assert.Equal(t, "orders", headers.Get("X-Tenant"))
+
+	_, err = ParseHeaders([]string{"Authorization"})
+	assert.Error(t, err)
+
+	_, err = ParseHeaders([]string{": value"})
+	assert.Error(t, err)
+}
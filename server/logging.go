@@ -2,14 +2,38 @@ package server
 
 import (
 	"context"
-	"log"
+	"time"
 
 	"github.com/bufbuild/connect-go"
+	"go.uber.org/zap"
 )
 
-func LoggingInterceptor(next connect.UnaryFunc) connect.UnaryFunc {
-	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		log.Printf("Request: %v", req.Spec().Procedure)
-		return next(ctx, req)
+// LoggingInterceptor logs each request with its procedure, duration and, for
+// failed requests, the error code returned.
+func LoggingInterceptor(logger *zap.Logger) connect.UnaryInterceptorFunc {
+	logger = logger.With(zap.String("component", "api"))
+
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+
+			fields := []zap.Field{
+				zap.String("procedure", req.Spec().Procedure),
+				zap.String("peer_addr", req.Peer().Addr),
+				zap.Duration("duration", time.Since(start)),
+			}
+
+			switch code := connect.CodeOf(err); {
+			case err == nil:
+				logger.Info("request handled", fields...)
+			case code == connect.CodeInternal || code == connect.CodeUnknown:
+				logger.Error("request failed", append(fields, zap.String("code", code.String()), zap.Error(err))...)
+			default:
+				logger.Warn("request failed", append(fields, zap.String("code", code.String()), zap.Error(err))...)
+			}
+
+			return resp, err
+		}
 	}
 }
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+
+	"github.com/JoeReid/jetbridge/tracing"
+	"github.com/bufbuild/connect-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TracingInterceptor(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header()))
+
+		ctx, span := tracing.Tracer().Start(ctx, req.Spec().Procedure, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+			attribute.String("rpc.system", "connect_rpc"),
+			attribute.String("rpc.method", req.Spec().Procedure),
+		))
+		defer span.End()
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", connect.CodeOf(err).String()))
+		}
+
+		return resp, err
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"time"
 
 	v1 "github.com/JoeReid/jetbridge/proto/gen/go/jetbridge/v1"
@@ -21,8 +20,14 @@ var _ v1connect.JetbridgeServiceHandler = (*V1)(nil)
 type V1 struct {
 	v1connect.UnimplementedJetbridgeServiceHandler
 
-	Bindings repositories.Bindings
-	Peers    repositories.Peers
+	Bindings  repositories.Bindings
+	Peers     repositories.Peers
+	Consumers repositories.Consumers
+	Stats     repositories.Stats
+
+	// Targets, if set, verifies the target of each binding as it is created or
+	// updated.
+	Targets repositories.TargetVerifier
 }
 
 func (v *V1) ListPeers(ctx context.Context, req *connect.Request[v1.ListPeersRequest]) (*connect.Response[v1.ListPeersResponse], error) {
@@ -43,6 +48,8 @@ func (v *V1) ListPeers(ctx context.Context, req *connect.Request[v1.ListPeersReq
 			Joined:       timestamppb.New(peer.JoinedAt),
 			LastSeen:     timestamppb.New(peer.LastSeenAt),
 			HeartbeatDue: timestamppb.New(peer.HeartbeatDueBy),
+			Weight:       peer.Weight,
+			Labels:       peer.Labels,
 		})
 	}
 
@@ -59,59 +66,76 @@ func (v *V1) CreateBinding(ctx context.Context, req *connect.Request[v1.CreateBi
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	var deliveryPolicy string
+	var deliveryPolicy repositories.DeliveryPolicy
 	switch req.Msg.DeliveryPolicy.(type) {
 	case *v1.CreateBindingRequest_Policy:
-		deliveryPolicy = req.Msg.GetPolicy()
+		p, err := repositories.ParseDeliveryPolicy(req.Msg.GetPolicy())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		deliveryPolicy = p
 	case *v1.CreateBindingRequest_StartTime:
-		deliveryPolicy = req.Msg.GetStartTime().AsTime().Format(time.RFC3339)
+		deliveryPolicy = repositories.DeliveryPolicy{
+			Deliver:   repositories.DeliverByStartTime,
+			StartTime: req.Msg.GetStartTime().AsTime(),
+		}
 	case *v1.CreateBindingRequest_StartSequence:
-		deliveryPolicy = fmt.Sprintf("%d", req.Msg.GetStartSequence())
+		deliveryPolicy = repositories.DeliveryPolicy{
+			Deliver:       repositories.DeliverByStartSequence,
+			StartSequence: req.Msg.GetStartSequence(),
+		}
 	default:
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid delivery policy"))
 	}
 
-	binding, err := v.Bindings.CreateJetstreamBinding(ctx, &repositories.CreateJetstreamBinding{
-		LambdaARN:      req.Msg.LambdaArn,
-		Stream:         req.Msg.Stream,
-		Subject:        req.Msg.SubjectPattern,
-		MaxMessages:    int(req.Msg.MaxBatchSize),
-		MaxLatency:     req.Msg.MaxBatchLatency.AsDuration(),
-		DeliveryPolicy: deliveryPolicy,
-	})
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	target := req.Msg.Target
+	switch {
+	case req.Msg.LambdaArn != "" && target != nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only one of lambda_arn and target may be set"))
+	case req.Msg.LambdaArn != "":
+		// Clients from before bindings had targets only give lambda_arn
+		target = &v1.Target{Target: &v1.Target_Lambda{Lambda: &v1.LambdaTarget{FunctionArn: req.Msg.LambdaArn}}}
+	case target == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("target must be set"))
 	}
 
-	v1Binding := &v1.JetstreamBinding{
-		Id:              binding.ID.String(),
-		LambdaArn:       binding.LambdaARN,
-		Stream:          binding.Stream,
-		ConsumerName:    binding.Consumer.String(),
-		SubjectPattern:  binding.Subject,
-		MaxBatchSize:    int64(binding.MaxMessages),
-		MaxBatchLatency: durationpb.New(binding.MaxLatency),
+	create := &repositories.CreateJetstreamBinding{
+		Target:            newTarget(target),
+		Stream:            req.Msg.Stream,
+		Subject:           req.Msg.SubjectPattern,
+		MaxMessages:       int(req.Msg.MaxBatchSize),
+		MaxLatency:        req.Msg.MaxBatchLatency.AsDuration(),
+		DeliveryPolicy:    deliveryPolicy,
+		MaxDeliveries:     int(req.Msg.MaxDeliveries),
+		DeadLetterSubject: req.Msg.DeadLetterSubject,
+		RetryPolicy:       newRetryPolicy(req.Msg.RetryPolicy),
+		InvocationType:    newInvocationType(req.Msg.InvocationType),
+		PayloadFormat:     newPayloadFormat(req.Msg.PayloadFormat),
+		MaxConcurrency:    int(req.Msg.MaxConcurrency),
+		PartitionKey:      newPartitionKey(req.Msg.PartitionKey),
+		RequiredLabels:    req.Msg.RequiredLabels,
 	}
 
-	switch req.Msg.DeliveryPolicy.(type) {
-	case *v1.CreateBindingRequest_Policy:
-		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_Policy{
-			Policy: req.Msg.GetPolicy(),
-		}
-	case *v1.CreateBindingRequest_StartTime:
-		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartTime{
-			StartTime: req.Msg.GetStartTime(),
-		}
-	case *v1.CreateBindingRequest_StartSequence:
-		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartSequence{
-			StartSequence: req.Msg.GetStartSequence(),
-		}
-	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid delivery policy"))
+	if err := create.Target.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if binding.AssignedPeerID != nil {
-		v1Binding.AssignedPeer = binding.AssignedPeerID.String()
+	if err := create.Binding().Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := v.verifyTarget(ctx, create.Target); err != nil {
+		return nil, err
+	}
+
+	binding, err := v.Bindings.CreateJetstreamBinding(ctx, create)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	v1Binding, err := newV1JetstreamBinding(binding)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	return connect.NewResponse(&v1.CreateBindingResponse{Binding: v1Binding}), nil
@@ -128,101 +152,217 @@ func (v *V1) GetBinding(ctx context.Context, req *connect.Request[v1.GetBindingR
 	}
 
 	binding, err := v.Bindings.GetJetstreamBinding(ctx, id)
+	if err != nil {
+		return nil, bindingError(err)
+	}
+
+	v1Binding, err := newV1JetstreamBinding(binding)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	v1Binding := &v1.JetstreamBinding{
-		Id:              binding.ID.String(),
-		LambdaArn:       binding.LambdaARN,
-		Stream:          binding.Stream,
-		ConsumerName:    binding.Consumer.String(),
-		SubjectPattern:  binding.Subject,
-		MaxBatchSize:    int64(binding.MaxMessages),
-		MaxBatchLatency: durationpb.New(binding.MaxLatency),
+	return connect.NewResponse(&v1.GetBindingResponse{Binding: v1Binding}), nil
+}
+
+func (v *V1) ListBindings(ctx context.Context, req *connect.Request[v1.ListBindingsRequest]) (*connect.Response[v1.ListBindingsResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	switch binding.DeliveryPolicy {
-	case "all", "last", "last-per-subject", "new":
-		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_Policy{
-			Policy: binding.DeliveryPolicy,
+	bindings, err := v.Bindings.ListJetstreamBindings(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var v1Bindings []*v1.JetstreamBinding
+	for _, binding := range bindings {
+		v1Binding, err := newV1JetstreamBinding(&binding)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
 		}
 
-	default:
-		if t, err := time.Parse(time.RFC3339, binding.DeliveryPolicy); err == nil {
-			v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartTime{
-				StartTime: timestamppb.New(t),
-			}
+		v1Bindings = append(v1Bindings, v1Binding)
+	}
+
+	resp := connect.NewResponse(&v1.ListBindingsResponse{Bindings: v1Bindings})
+	if err := resp.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (v *V1) UpdateBinding(ctx context.Context, req *connect.Request[v1.UpdateBindingRequest]) (*connect.Response[v1.UpdateBindingResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	update := &repositories.UpdateJetstreamBinding{
+		Subject:           req.Msg.SubjectPattern,
+		DeadLetterSubject: req.Msg.DeadLetterSubject,
+	}
+
+	if req.Msg.Target != nil {
+		target := newTarget(req.Msg.Target)
+		if err := target.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
 
-		if i, err := strconv.ParseUint(binding.DeliveryPolicy, 10, 64); err == nil {
-			v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartSequence{
-				StartSequence: i,
-			}
+		if err := v.verifyTarget(ctx, target); err != nil {
+			return nil, err
 		}
+		update.Target = &target
+	}
 
-		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid delivery policy"))
+	if req.Msg.MaxBatchSize != nil {
+		maxMessages := int(req.Msg.GetMaxBatchSize())
+		update.MaxMessages = &maxMessages
 	}
 
-	if binding.AssignedPeerID != nil {
-		v1Binding.AssignedPeer = binding.AssignedPeerID.String()
+	if req.Msg.MaxBatchLatency != nil {
+		maxLatency := req.Msg.MaxBatchLatency.AsDuration()
+		update.MaxLatency = &maxLatency
 	}
 
-	return connect.NewResponse(&v1.GetBindingResponse{Binding: v1Binding}), nil
+	if req.Msg.MaxDeliveries != nil {
+		maxDeliveries := int(req.Msg.GetMaxDeliveries())
+		update.MaxDeliveries = &maxDeliveries
+	}
+
+	if req.Msg.RetryPolicy != nil {
+		retryPolicy := newRetryPolicy(req.Msg.RetryPolicy)
+		update.RetryPolicy = &retryPolicy
+	}
+
+	if req.Msg.InvocationType != nil {
+		invocationType := newInvocationType(req.Msg.GetInvocationType())
+		update.InvocationType = &invocationType
+	}
+
+	if req.Msg.PayloadFormat != nil {
+		payloadFormat := newPayloadFormat(req.Msg.GetPayloadFormat())
+		update.PayloadFormat = &payloadFormat
+	}
+
+	if req.Msg.MaxConcurrency != nil {
+		maxConcurrency := int(req.Msg.GetMaxConcurrency())
+		update.MaxConcurrency = &maxConcurrency
+	}
+
+	if req.Msg.PartitionKey != nil {
+		partitionKey := newPartitionKey(req.Msg.PartitionKey)
+		update.PartitionKey = &partitionKey
+	}
+
+	if req.Msg.RequiredLabels != nil {
+		requiredLabels := repositories.Labels(req.Msg.RequiredLabels.Labels)
+		update.RequiredLabels = &requiredLabels
+	}
+
+	binding, err := v.Bindings.UpdateJetstreamBinding(ctx, id, update)
+	if err != nil {
+		return nil, bindingError(err)
+	}
+
+	v1Binding, err := newV1JetstreamBinding(binding)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.UpdateBindingResponse{Binding: v1Binding}), nil
 }
 
-func (v *V1) ListBindings(ctx context.Context, req *connect.Request[v1.ListBindingsRequest]) (*connect.Response[v1.ListBindingsResponse], error) {
+func (v *V1) DeleteBinding(ctx context.Context, req *connect.Request[v1.DeleteBindingRequest]) (*connect.Response[v1.DeleteBindingResponse], error) {
 	if err := req.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	bindings, err := v.Bindings.ListJetstreamBindings(ctx)
+	id, err := uuid.Parse(req.Msg.Id)
 	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	binding, err := v.Bindings.GetJetstreamBinding(ctx, id)
+	if err != nil {
+		return nil, bindingError(err)
+	}
+
+	// Delete the binding before its consumer, otherwise the worker running the
+	// binding would recreate the consumer from the start of its delivery policy.
+	// The worker stops, releasing its subscriptions, once it sees the deletion.
+	if err := v.Bindings.DeleteJetstreamBinding(ctx, id); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	var v1Bindings []*v1.JetstreamBinding
-	for _, binding := range bindings {
-		v1Binding := &v1.JetstreamBinding{
-			Id:              binding.ID.String(),
-			LambdaArn:       binding.LambdaARN,
-			Stream:          binding.Stream,
-			ConsumerName:    binding.Consumer.String(),
-			SubjectPattern:  binding.Subject,
-			MaxBatchSize:    int64(binding.MaxMessages),
-			MaxBatchLatency: durationpb.New(binding.MaxLatency),
-		}
-
-		switch binding.DeliveryPolicy {
-		case "all", "last", "last-per-subject", "new":
-			v1Binding.DeliveryPolicy = &v1.JetstreamBinding_Policy{
-				Policy: binding.DeliveryPolicy,
-			}
+	err = v.Consumers.DeleteConsumer(ctx, repositories.Consumer{Stream: binding.Stream, Name: binding.Consumer})
+	if err != nil && !errors.Is(err, repositories.ErrConsumerNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("binding deleted, but its consumer will remain until garbage collected: %w", err))
+	}
 
-		default:
-			if t, err := time.Parse(time.RFC3339, binding.DeliveryPolicy); err == nil {
-				v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartTime{
-					StartTime: timestamppb.New(t),
-				}
-			}
+	return connect.NewResponse(&v1.DeleteBindingResponse{}), nil
+}
 
-			if i, err := strconv.ParseUint(binding.DeliveryPolicy, 10, 64); err == nil {
-				v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartSequence{
-					StartSequence: i,
-				}
-			}
+func (v *V1) GetBindingStatus(ctx context.Context, req *connect.Request[v1.GetBindingStatusRequest]) (*connect.Response[v1.GetBindingStatusResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
-			return nil, connect.NewError(connect.CodeInternal, errors.New("invalid delivery policy"))
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	binding, err := v.Bindings.GetJetstreamBinding(ctx, id)
+	if err != nil {
+		return nil, bindingError(err)
+	}
+
+	status := &v1.BindingStatus{BindingId: binding.ID.String()}
+
+	consumer, err := v.Consumers.GetConsumerStatus(ctx, *binding)
+	switch {
+	case errors.Is(err, repositories.ErrConsumerNotFound):
+		// The binding has not yet been run, so there is no consumer to report on
+
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+
+	default:
+		status.Consumer = &v1.ConsumerStatus{
+			NumPending:                    consumer.NumPending,
+			NumAckPending:                 int64(consumer.NumAckPending),
+			NumRedelivered:                int64(consumer.NumRedelivered),
+			LastDeliveredStreamSequence:   consumer.LastDeliveredStreamSequence,
+			LastDeliveredConsumerSequence: consumer.LastDeliveredConsumerSequence,
 		}
+	}
+
+	stats, err := v.Stats.GetBindingStats(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if stats != nil {
+		status.Running = stats.Running(binding.AssignedPeerID, time.Now())
+		status.ReportedBy = stats.PeerID.String()
+		status.Reported = timestamppb.New(stats.RecordedAt)
+		status.LastErrorMessage = stats.LastError
 
-		if binding.AssignedPeerID != nil {
-			v1Binding.AssignedPeer = binding.AssignedPeerID.String()
+		if !stats.LastSuccessAt.IsZero() {
+			status.LastSuccess = timestamppb.New(stats.LastSuccessAt)
 		}
 
-		v1Bindings = append(v1Bindings, v1Binding)
+		if !stats.LastErrorAt.IsZero() {
+			status.LastError = timestamppb.New(stats.LastErrorAt)
+		}
 	}
 
-	resp := connect.NewResponse(&v1.ListBindingsResponse{Bindings: v1Bindings})
+	resp := connect.NewResponse(&v1.GetBindingStatusResponse{Status: status})
 	if err := resp.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -230,19 +370,272 @@ func (v *V1) ListBindings(ctx context.Context, req *connect.Request[v1.ListBindi
 	return resp, nil
 }
 
-func (v *V1) DeleteBinding(ctx context.Context, req *connect.Request[v1.DeleteBindingRequest]) (*connect.Response[v1.DeleteBindingResponse], error) {
+func (v *V1) PauseBinding(ctx context.Context, req *connect.Request[v1.PauseBindingRequest]) (*connect.Response[v1.PauseBindingResponse], error) {
 	if err := req.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	id, err := uuid.Parse(req.Msg.Id)
+	v1Binding, err := v.setPaused(ctx, req.Msg.Id, true)
 	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.PauseBindingResponse{Binding: v1Binding}), nil
+}
+
+func (v *V1) ResumeBinding(ctx context.Context, req *connect.Request[v1.ResumeBindingRequest]) (*connect.Response[v1.ResumeBindingResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := v.Bindings.DeleteJetstreamBinding(ctx, id); err != nil {
+	v1Binding, err := v.setPaused(ctx, req.Msg.Id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ResumeBindingResponse{Binding: v1Binding}), nil
+}
+
+// setPaused pauses or resumes a binding. Workers stop running paused bindings,
+// leaving their consumer in place so that delivery resumes where it left off.
+func (v *V1) setPaused(ctx context.Context, rawID string, paused bool) (*v1.JetstreamBinding, error) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	binding, err := v.Bindings.UpdateJetstreamBinding(ctx, id, &repositories.UpdateJetstreamBinding{
+		Paused: &paused,
+	})
+	if err != nil {
+		return nil, bindingError(err)
+	}
+
+	v1Binding, err := newV1JetstreamBinding(binding)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	return connect.NewResponse(&v1.DeleteBindingResponse{}), nil
+	return v1Binding, nil
+}
+
+func newV1JetstreamBinding(binding *repositories.JetstreamBinding) (*v1.JetstreamBinding, error) {
+	v1Binding := &v1.JetstreamBinding{
+		Id:                binding.ID.String(),
+		Target:            newV1Target(binding.Target),
+		Stream:            binding.Stream,
+		ConsumerName:      binding.Consumer.String(),
+		SubjectPattern:    binding.Subject,
+		MaxBatchSize:      int64(binding.MaxMessages),
+		MaxBatchLatency:   durationpb.New(binding.MaxLatency),
+		MaxDeliveries:     int64(binding.MaxDeliveries),
+		DeadLetterSubject: binding.DeadLetterSubject,
+		RetryPolicy: &v1.RetryPolicy{
+			InitialDelay: durationpb.New(binding.RetryPolicy.InitialDelay),
+			Multiplier:   binding.RetryPolicy.Multiplier,
+			MaxDelay:     durationpb.New(binding.RetryPolicy.MaxDelay),
+			Jitter:       binding.RetryPolicy.Jitter,
+		},
+		InvocationType: newV1InvocationType(binding.InvocationType),
+		PayloadFormat:  newV1PayloadFormat(binding.PayloadFormat),
+		MaxConcurrency: int64(binding.MaxConcurrency),
+		PartitionKey:   newV1PartitionKey(binding.PartitionKey),
+		Paused:         binding.Paused,
+		RequiredLabels: binding.RequiredLabels,
+	}
+
+	if binding.Target.Type == repositories.TargetLambda {
+		v1Binding.LambdaArn = binding.Target.URI
+	}
+
+	switch binding.DeliveryPolicy.Deliver {
+	case repositories.DeliverAll, repositories.DeliverLast, repositories.DeliverLastPerSubject, repositories.DeliverNew:
+		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_Policy{
+			Policy: binding.DeliveryPolicy.String(),
+		}
+
+	case repositories.DeliverByStartTime:
+		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartTime{
+			StartTime: timestamppb.New(binding.DeliveryPolicy.StartTime),
+		}
+
+	case repositories.DeliverByStartSequence:
+		v1Binding.DeliveryPolicy = &v1.JetstreamBinding_StartSequence{
+			StartSequence: binding.DeliveryPolicy.StartSequence,
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid delivery policy: %s", binding.DeliveryPolicy)
+	}
+
+	if binding.AssignedPeerID != nil {
+		v1Binding.AssignedPeer = binding.AssignedPeerID.String()
+	}
+
+	return v1Binding, nil
+}
+
+// bindingError converts an error from the Bindings repository, reporting
+// bindings that do not exist as not found.
+func bindingError(err error) error {
+	if errors.Is(err, repositories.ErrBindingNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	if errors.Is(err, repositories.ErrInvalidBinding) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func newRetryPolicy(policy *v1.RetryPolicy) repositories.RetryPolicy {
+	return repositories.RetryPolicy{
+		InitialDelay: policy.GetInitialDelay().AsDuration(),
+		Multiplier:   policy.GetMultiplier(),
+		MaxDelay:     policy.GetMaxDelay().AsDuration(),
+		Jitter:       policy.GetJitter(),
+	}
+}
+
+// verifyTarget checks the target exists, if the server has a TargetVerifier.
+func (v *V1) verifyTarget(ctx context.Context, target repositories.Target) error {
+	if v.Targets == nil {
+		return nil
+	}
+
+	if err := v.Targets.VerifyTarget(ctx, target); err != nil {
+		if errors.Is(err, repositories.ErrTargetNotFound) {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
+
+// redacted replaces the values of webhook headers in responses.
+const redacted = "REDACTED"
+
+func newTarget(target *v1.Target) repositories.Target {
+	switch t := target.GetTarget().(type) {
+	case *v1.Target_Webhook:
+		return repositories.Target{
+			Type: repositories.TargetWebhook,
+			URI:  t.Webhook.GetUrl(),
+			Webhook: repositories.WebhookConfig{
+				Headers:       t.Webhook.GetHeaders(),
+				SigningSecret: t.Webhook.GetSigningSecret(),
+				Timeout:       t.Webhook.GetTimeout().AsDuration(),
+				Insecure:      t.Webhook.GetInsecure(),
+			},
+		}
+	case *v1.Target_Nats:
+		return repositories.Target{Type: repositories.TargetNATS, URI: t.Nats.GetSubject()}
+	default:
+		return repositories.Target{
+			Type:      repositories.TargetLambda,
+			URI:       target.GetLambda().GetFunctionArn(),
+			Qualifier: target.GetLambda().GetQualifier(),
+		}
+	}
+}
+
+func newV1Target(target repositories.Target) *v1.Target {
+	switch target.Type {
+	case repositories.TargetWebhook:
+		// Header values and the signing secret are credentials, so cannot be
+		// read back by anyone able to list bindings
+		webhook := &v1.WebhookTarget{
+			Url:      target.URI,
+			Insecure: target.Webhook.Insecure,
+		}
+
+		for name := range target.Webhook.Headers {
+			if webhook.Headers == nil {
+				webhook.Headers = make(map[string]string, len(target.Webhook.Headers))
+			}
+			webhook.Headers[name] = redacted
+		}
+
+		if target.Webhook.Timeout > 0 {
+			webhook.Timeout = durationpb.New(target.Webhook.Timeout)
+		}
+
+		return &v1.Target{Target: &v1.Target_Webhook{Webhook: webhook}}
+	case repositories.TargetNATS:
+		return &v1.Target{Target: &v1.Target_Nats{Nats: &v1.NatsTarget{Subject: target.URI}}}
+	default:
+		return &v1.Target{Target: &v1.Target_Lambda{Lambda: &v1.LambdaTarget{
+			FunctionArn: target.URI,
+			Qualifier:   target.Qualifier,
+		}}}
+	}
+}
+
+func newInvocationType(invocationType v1.InvocationType) repositories.InvocationType {
+	switch invocationType {
+	case v1.InvocationType_INVOCATION_TYPE_EVENT:
+		return repositories.InvocationEvent
+	case v1.InvocationType_INVOCATION_TYPE_DRY_RUN:
+		return repositories.InvocationDryRun
+	default:
+		return repositories.InvocationRequestResponse
+	}
+}
+
+func newV1InvocationType(invocationType repositories.InvocationType) v1.InvocationType {
+	switch invocationType {
+	case repositories.InvocationEvent:
+		return v1.InvocationType_INVOCATION_TYPE_EVENT
+	case repositories.InvocationDryRun:
+		return v1.InvocationType_INVOCATION_TYPE_DRY_RUN
+	default:
+		return v1.InvocationType_INVOCATION_TYPE_REQUEST_RESPONSE
+	}
+}
+
+func newPayloadFormat(payloadFormat v1.PayloadFormat) repositories.PayloadFormat {
+	switch payloadFormat {
+	case v1.PayloadFormat_PAYLOAD_FORMAT_JSON:
+		return repositories.PayloadFormatJSON
+	case v1.PayloadFormat_PAYLOAD_FORMAT_CLOUDEVENTS:
+		return repositories.PayloadFormatCloudEvents
+	case v1.PayloadFormat_PAYLOAD_FORMAT_RAW:
+		return repositories.PayloadFormatRaw
+	default:
+		return repositories.PayloadFormatJetstream
+	}
+}
+
+func newV1PayloadFormat(payloadFormat repositories.PayloadFormat) v1.PayloadFormat {
+	switch payloadFormat {
+	case repositories.PayloadFormatJSON:
+		return v1.PayloadFormat_PAYLOAD_FORMAT_JSON
+	case repositories.PayloadFormatCloudEvents:
+		return v1.PayloadFormat_PAYLOAD_FORMAT_CLOUDEVENTS
+	case repositories.PayloadFormatRaw:
+		return v1.PayloadFormat_PAYLOAD_FORMAT_RAW
+	default:
+		return v1.PayloadFormat_PAYLOAD_FORMAT_JETSTREAM
+	}
+}
+
+func newPartitionKey(key *v1.PartitionKey) repositories.PartitionKey {
+	return repositories.PartitionKey{
+		SubjectToken: int(key.GetSubjectToken()),
+		Header:       key.GetHeader(),
+	}
+}
+
+func newV1PartitionKey(key repositories.PartitionKey) *v1.PartitionKey {
+	switch {
+	case key.SubjectToken > 0:
+		return &v1.PartitionKey{Key: &v1.PartitionKey_SubjectToken{SubjectToken: uint32(key.SubjectToken)}}
+	case key.Header != "":
+		return &v1.PartitionKey{Key: &v1.PartitionKey_Header{Header: key.Header}}
+	default:
+		return nil
+	}
 }
@@ -0,0 +1,136 @@
+// Package tracing configures OpenTelemetry tracing for the jetbridge server,
+// and propagates W3C trace context between NATS messages and the targets of
+// bindings.
+package tracing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/JoeReid/jetbridge"
+
+// Tracer returns the tracer used to instrument jetbridge.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Config configures the export of traces.
+type Config struct {
+	// Endpoint is the host and port of an OTLP/HTTP collector. If empty,
+	// trace context is still propagated but no spans are exported.
+	Endpoint string
+	// Insecure disables TLS when connecting to Endpoint.
+	Insecure bool
+	// ServiceName identifies this server in exported traces.
+	ServiceName string
+}
+
+// Setup installs the global tracer provider and W3C trace context
+// propagator. The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(config.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Extract returns a copy of ctx carrying the trace context found in the
+// headers of a NATS message, if any.
+func Extract(ctx context.Context, header nats.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, headerCarrier(header))
+}
+
+// Inject adds the trace context of ctx to the headers of an HTTP request, so
+// that a webhook can continue the trace.
+func Inject(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectNATS adds the trace context of ctx to the headers of a NATS message,
+// so that a NATS service can continue the trace.
+func InjectNATS(ctx context.Context, header nats.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier(header))
+}
+
+// ClientContext encodes the trace context of ctx as a Lambda ClientContext,
+// under the "custom" key, so the function can continue the trace. Lambda
+// only passes the ClientContext to synchronous invocations. It returns nil
+// if ctx carries no trace context.
+func ClientContext(ctx context.Context) (*string, error) {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	if len(carrier) == 0 {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"custom": carrier})
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(b)
+	return &encoded, nil
+}
+
+// headerCarrier adapts nats.Header to a propagation.TextMapCarrier. Unlike
+// HTTP, NATS does not canonicalise header names, so lookups ignore case.
+type headerCarrier nats.Header
+
+func (h headerCarrier) Get(key string) string {
+	if v := nats.Header(h).Get(key); v != "" {
+		return v
+	}
+
+	for k, v := range h {
+		if strings.EqualFold(k, key) && len(v) > 0 {
+			return v[0]
+		}
+	}
+
+	return ""
+}
+
+func (h headerCarrier) Set(key, value string) {
+	nats.Header(h).Set(key, value)
+}
+
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestExtract(t *testing.T) {
+	_, err := Setup(context.TODO(), Config{})
+	require.NoError(t, err)
+
+	for _, name := range []string{"traceparent", "Traceparent", "TRACEPARENT"} {
+		t.Run(name, func(t *testing.T) {
+			ctx := Extract(context.TODO(), nats.Header{name: []string{traceparent}})
+
+			sc := trace.SpanContextFromContext(ctx)
+			assert.True(t, sc.IsValid())
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID().String())
+			assert.Equal(t, "00f067aa0ba902b7", sc.SpanID().String())
+		})
+	}
+
+	t.Run("no trace context", func(t *testing.T) {
+		ctx := Extract(context.TODO(), nil)
+		assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+	})
+}
+
+func TestClientContext(t *testing.T) {
+	_, err := Setup(context.TODO(), Config{})
+	require.NoError(t, err)
+
+	t.Run("trace context", func(t *testing.T) {
+		ctx := Extract(context.TODO(), nats.Header{"traceparent": []string{traceparent}})
+
+		clientContext, err := ClientContext(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, clientContext)
+
+		b, err := base64.StdEncoding.DecodeString(*clientContext)
+		require.NoError(t, err)
+
+		var decoded struct {
+			Custom map[string]string `json:"custom"`
+		}
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		assert.Equal(t, traceparent, decoded.Custom["traceparent"])
+	})
+
+	t.Run("no trace context", func(t *testing.T) {
+		clientContext, err := ClientContext(context.TODO())
+		require.NoError(t, err)
+		assert.Nil(t, clientContext)
+	})
+}
@@ -0,0 +1,69 @@
+package jetbridge
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BindingIDHeader is the ID of the binding delivering the messages, set on the
+// requests made to webhook and NATS targets.
+const BindingIDHeader = "Jetbridge-Binding-Id"
+
+// Headers added to the requests made to webhook targets. The body of each
+// request is encoded in the binding's payload format.
+const (
+	// WebhookTimestampHeader is the Unix time, in seconds, the request was signed at.
+	WebhookTimestampHeader = "Jetbridge-Timestamp"
+	// WebhookSignatureHeader is the signature of a request, only set if the
+	// server has a webhook signing secret. See SignWebhook.
+	WebhookSignatureHeader = "Jetbridge-Signature"
+)
+
+// webhookSignaturePrefix identifies the algorithm used by a webhook signature.
+const webhookSignaturePrefix = "sha256="
+
+// SignWebhook returns the signature of a webhook request body sent at
+// timestamp, as set in the WebhookSignatureHeader. The signature is the hex
+// encoded HMAC-SHA256, keyed by secret, of the timestamp in Unix seconds, a
+// full stop, and the body, prefixed with "sha256=".
+func SignWebhook(secret []byte, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook checks the signature of a webhook request received by a
+// target, given its headers and body. Requests signed more than tolerance
+// before now are rejected to limit replay attacks, unless tolerance is zero.
+func VerifyWebhook(secret []byte, header http.Header, body []byte, tolerance time.Duration) error {
+	unix, err := strconv.ParseInt(header.Get(WebhookTimestampHeader), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header: %w", WebhookTimestampHeader, err)
+	}
+
+	timestamp := time.Unix(unix, 0)
+	if tolerance > 0 && time.Since(timestamp) > tolerance {
+		return fmt.Errorf("webhook signed at %s is too old", timestamp.Format(time.RFC3339))
+	}
+
+	signature := header.Get(WebhookSignatureHeader)
+	if !strings.HasPrefix(signature, webhookSignaturePrefix) {
+		return fmt.Errorf("missing or unsupported %s header", WebhookSignatureHeader)
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(SignWebhook(secret, timestamp, body))) {
+		return errors.New("webhook signature does not match")
+	}
+
+	return nil
+}